@@ -0,0 +1,234 @@
+// Package character provides a kinematic character controller. A circle or upright capsule collider is moved
+// by a desired displacement through static line segments, polygons and rectangles and moving platforms,
+// sliding along what it meets, stepping up low obstacles and reporting the ground it stands on.
+package character
+
+import (
+	"collision/internal/vec"
+	"collision/point"
+	"math"
+)
+
+// Defaults for new controllers
+const (
+	DefaultMaxSlope        = math.Pi / 4 // steepest slope, in radians, that counts as ground
+	DefaultSlideIterations = 4           // most surfaces slid along in one move
+	DefaultSkinWidth       = 0.01        // gap kept between the collider and surfaces
+)
+
+// depenetrationIterations - most surfaces the collider is pushed out of before a move
+const depenetrationIterations = 4
+
+// Controller - kinematic character collider and its movement settings. The character is moved only by Move,
+// never by the geometry it meets, and +Y is up.
+type Controller struct {
+	Position        point.Point // centre of collider
+	MaxSlope        float32     // steepest slope, in radians, that counts as ground rather than wall
+	StepHeight      float32     // tallest obstacle a grounded character steps up onto, and furthest it snaps down
+	SlideIterations int         // most surfaces slid along in one move
+	SkinWidth       float32     // gap kept between the collider and surfaces, so it starts each move clear of them
+	collider        Collider
+	grounded        bool      // character ended its last move on the ground
+	platform        *Platform // platform stood on at the end of the last move
+	platformAt      vec.Vec   // position of that platform at the end of the last move
+}
+
+// MoveResult - where a move ended and what the character touched on the way
+type MoveResult struct {
+	Position     point.Point // centre of collider at the end of the move
+	Grounded     bool        // character is standing on ground no steeper than MaxSlope
+	GroundNormal point.Point // unit normal of the ground, zero if not grounded
+	Platform     *Platform   // platform stood on, nil if the ground is static or there is none
+	OnWall       bool        // character slid along a wall, or a slope too steep to stand on
+	OnCeiling    bool        // character slid along a ceiling
+	SteppedUp    bool        // character stepped up onto an obstacle
+}
+
+// NewController - returns a pointer to a controller with the collider centred at position, and default settings
+func NewController(collider Collider, position point.Point) *Controller {
+	return &Controller{
+		Position:        position,
+		MaxSlope:        DefaultMaxSlope,
+		SlideIterations: DefaultSlideIterations,
+		SkinWidth:       DefaultSkinWidth,
+		collider:        collider,
+	}
+}
+
+// Collider - collider of controller
+func (c *Controller) Collider() Collider {
+	return c.collider
+}
+
+// Grounded - boolean indicating whether the character ended its last move on the ground
+func (c *Controller) Grounded() bool {
+	return c.grounded
+}
+
+// Move - move the character by displacement through the geometry and return where it ended. A character
+// standing on a platform is first carried by the platform's movement since the last move. The displacement is
+// then made in straight runs, each ending where the collider meets a surface: ground turns the rest of the
+// displacement along itself without sliding downhill, walls and ceilings remove the part of it heading into
+// them, and a grounded character meeting a wall first tries to step up onto it. Finally the character is
+// snapped down to any ground just beneath it.
+func (c *Controller) Move(displacement point.Point, g *Geometry) MoveResult {
+	m := c.mover(g)
+	position := vec.FromPoint(c.Position)
+	if c.platform != nil {
+		position = position.Add(vec.FromPoint(c.platform.Position).Sub(c.platformAt))
+	}
+	position = m.depenetrate(position)
+
+	var result MoveResult
+	remaining := vec.FromPoint(displacement)
+	for i := 0; i < c.SlideIterations && remaining != (vec.Vec{}); i++ {
+		h, ok := m.cast(position, remaining)
+		if !ok {
+			position = position.Add(remaining)
+			break
+		}
+		position = position.Add(remaining.Scale(h.fraction))
+		remaining = remaining.Scale(1 - h.fraction)
+		switch {
+		case m.walkable(h.normal):
+			remaining = slide(vec.Vec{X: remaining.X, Y: 0}, h.normal)
+		case h.normal.Y <= -m.cosSlope:
+			result.OnCeiling = true
+			remaining = slide(remaining, h.normal)
+		default:
+			result.OnWall = true
+			if c.grounded && !result.SteppedUp && c.StepHeight > 0 {
+				if stepped, rest, ok := m.stepUp(position, remaining, float64(c.StepHeight)); ok {
+					position, remaining, result.SteppedUp = stepped, rest, true
+					continue
+				}
+			}
+			remaining = slideWall(remaining, h.normal)
+		}
+	}
+
+	// look for ground just beneath, and further if the character was walking on ground and may have walked
+	// off the top of a downward slope or step
+	probe := 2 * m.skin
+	if c.grounded && displacement.Y <= 0 {
+		probe += float64(c.StepHeight) + math.Abs(float64(displacement.X))*math.Tan(float64(c.MaxSlope))
+	}
+	down := vec.Vec{X: 0, Y: -probe}
+	if h, ok := m.cast(position, down); ok && m.walkable(h.normal) {
+		position = position.Add(down.Scale(h.fraction))
+		result.Grounded, result.GroundNormal, result.Platform = true, h.normal.Point(), h.platform
+	}
+
+	c.Position = position.Point()
+	c.grounded, c.platform = result.Grounded, result.Platform
+	if c.platform != nil {
+		c.platformAt = vec.FromPoint(c.platform.Position)
+	}
+	result.Position = c.Position
+	return result
+}
+
+// mover - collider and geometry of a single move
+type mover struct {
+	segments []segment
+	half     float64 // distance from centre to the centre of each rounded end
+	radius   float64 // radius of the rounded ends
+	skin     float64 // gap kept between collider and surfaces
+	cosSlope float64 // least upward component of the normal of ground
+}
+
+// mover - mover for a move of the controller through the geometry
+func (c *Controller) mover(g *Geometry) mover {
+	return mover{
+		segments: g.all(),
+		half:     float64(c.collider.half),
+		radius:   float64(c.collider.radius),
+		skin:     float64(c.SkinWidth),
+		cosSlope: math.Cos(float64(c.MaxSlope)),
+	}
+}
+
+// cast - first surface met by the collider moving from centre by displacement, stopping the skin width short
+func (m mover) cast(centre, displacement vec.Vec) (hit, bool) {
+	return cast(m.segments, centre, m.half, m.radius+m.skin, displacement)
+}
+
+// walkable - boolean indicating whether a surface with the normal is ground
+func (m mover) walkable(normal vec.Vec) bool {
+	return normal.Y >= m.cosSlope
+}
+
+// depenetrate - centre moved out of the surfaces the collider overlaps, deepest first, to the skin width clear
+func (m mover) depenetrate(centre vec.Vec) vec.Vec {
+	for i := 0; i < depenetrationIterations; i++ {
+		deepest, push := 0.0, vec.Vec{}
+		for _, s := range m.segments {
+			offset := vec.Vec{X: 0, Y: m.half}
+			onCore, onSegment := closestBetweenSegments(centre.Sub(offset), centre.Add(offset), s.start, s.end)
+			away := onCore.Sub(onSegment)
+			depth := m.radius - away.Length()
+			if depth <= deepest {
+				continue
+			}
+			normal := away.Unit()
+			if normal == (vec.Vec{}) {
+				// core crosses the segment, so push out to whichever side is up
+				along := s.end.Sub(s.start).Unit()
+				normal = along.LeftNormal()
+				if normal.Y < 0 || normal.Y == 0 && normal.X < 0 {
+					normal = normal.Scale(-1)
+				}
+			}
+			deepest, push = depth, normal.Scale(depth+m.skin)
+		}
+		if deepest == 0 {
+			break
+		}
+		centre = centre.Add(push)
+	}
+	return centre
+}
+
+// stepUp - position after stepping up and over an obstacle blocking the horizontal part of remaining, the part
+// of remaining still to be made, and boolean indicating whether the collider landed on ground beyond it. The
+// collider is raised by up to height, moved across, then dropped back down.
+func (m mover) stepUp(centre, remaining vec.Vec, height float64) (vec.Vec, vec.Vec, bool) {
+	across := vec.Vec{X: remaining.X, Y: 0}
+	if across == (vec.Vec{}) {
+		return centre, remaining, false
+	}
+	up := vec.Vec{X: 0, Y: height}
+	raised := centre.Add(up)
+	if h, ok := m.cast(centre, up); ok {
+		raised = centre.Add(up.Scale(h.fraction))
+	}
+	fraction := 1.0
+	if h, ok := m.cast(raised, across); ok {
+		fraction = h.fraction
+	}
+	if fraction*across.Length() <= m.skin {
+		return centre, remaining, false
+	}
+	beyond := raised.Add(across.Scale(fraction))
+	down := vec.Vec{X: 0, Y: centre.Y - raised.Y - m.skin}
+	h, ok := m.cast(beyond, down)
+	if !ok || !m.walkable(h.normal) {
+		return centre, remaining, false
+	}
+	return beyond.Add(down.Scale(h.fraction)), across.Scale(1 - fraction), true
+}
+
+// slide - displacement with the part along normal removed
+func slide(displacement, normal vec.Vec) vec.Vec {
+	return displacement.Sub(normal.Scale(displacement.Dot(normal)))
+}
+
+// slideWall - displacement slid along a wall. A slope too steep to stand on is treated as a vertical wall if
+// sliding along it would carry the character up it.
+func slideWall(displacement, normal vec.Vec) vec.Vec {
+	slid := slide(displacement, normal)
+	if normal.Y > 0 && slid.Y > 0 && displacement.Y <= 0 {
+		return slide(displacement, vec.Vec{X: normal.X, Y: 0}.Unit())
+	}
+	return slid
+}
@@ -0,0 +1,208 @@
+package character
+
+import (
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// segmentFrom - line segment between two points
+func segmentFrom(x0, y0, x1, y1 float32) line.LineSegment {
+	return line.LineSegment{Start: point.Point{X: x0, Y: y0}, End: point.Point{X: x1, Y: y1}}
+}
+
+// rectangle - XYRectangle with opposite corners at (x0, y0) and (x1, y1)
+func rectangle(t *testing.T, x0, y0, x1, y1 float32) *polygon.XYRectangle {
+	r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: x0, Y: y0}, {X: x1, Y: y1}})
+	assert.Nil(t, err)
+	return r
+}
+
+// flatGround - geometry with a floor along y = 0
+func flatGround() *Geometry {
+	g := &Geometry{}
+	g.AddLineSegment(segmentFrom(-100, 0, 100, 0))
+	return g
+}
+
+// TestMoveLanding - test that a falling character stops on the floor and is grounded
+func TestMoveLanding(t *testing.T) {
+	c := NewController(NewCircleCollider(0.5), point.Point{X: 0, Y: 2})
+	result := c.Move(point.Point{X: 0, Y: -0.5}, flatGround())
+	assert.False(t, result.Grounded)
+	assert.Equal(t, point.Point{X: 0, Y: 1.5}, result.Position)
+
+	result = c.Move(point.Point{X: 0, Y: -5}, flatGround())
+	assert.True(t, result.Grounded)
+	assert.True(t, c.Grounded())
+	assert.Equal(t, point.Point{X: 0, Y: 1}, result.GroundNormal)
+	assert.InDelta(t, 0.5+DefaultSkinWidth, result.Position.Y, 1e-6)
+	assert.Nil(t, result.Platform)
+
+	// capsule stands on its lower end
+	capsule := NewController(NewCapsuleCollider(0.5, 2), point.Point{X: 0, Y: 3})
+	radius, height := capsule.Collider().GetRadiusAndHeight()
+	assert.Equal(t, float32(0.5), radius)
+	assert.Equal(t, float32(2), height)
+	result = capsule.Move(point.Point{X: 0, Y: -5}, flatGround())
+	assert.True(t, result.Grounded)
+	assert.InDelta(t, 1+DefaultSkinWidth, result.Position.Y, 1e-6)
+}
+
+// TestMoveWall - test that a character is stopped by a wall and slides along it
+func TestMoveWall(t *testing.T) {
+	g := flatGround()
+	g.AddRectangle(rectangle(t, 3, 0, 4, 10))
+	c := NewController(NewCapsuleCollider(0.5, 2), point.Point{X: 0, Y: 5})
+
+	// diagonal move into the wall keeps its vertical part
+	result := c.Move(point.Point{X: 5, Y: -1}, g)
+	assert.True(t, result.OnWall)
+	assert.False(t, result.Grounded)
+	assert.InDelta(t, 2.5-DefaultSkinWidth, result.Position.X, 1e-5)
+	assert.InDelta(t, 4, result.Position.Y, 1e-5)
+
+	// pushing straight into the wall goes nowhere
+	before := c.Position
+	result = c.Move(point.Point{X: 1, Y: 0}, g)
+	assert.InDelta(t, before.X, result.Position.X, 1e-5)
+	assert.InDelta(t, before.Y, result.Position.Y, 1e-5)
+}
+
+// TestMoveCeiling - test that a jump into a ceiling is stopped and reported
+func TestMoveCeiling(t *testing.T) {
+	g := flatGround()
+	g.AddLineSegment(segmentFrom(-10, 3, 10, 3))
+	c := NewController(NewCircleCollider(0.5), point.Point{X: 0, Y: 0.51})
+	result := c.Move(point.Point{X: 1, Y: 4}, g)
+	assert.True(t, result.OnCeiling)
+	assert.InDelta(t, 2.5-DefaultSkinWidth, result.Position.Y, 1e-5)
+	assert.InDelta(t, 1, result.Position.X, 1e-5, "expect ceiling to leave horizontal movement")
+}
+
+// TestMoveSlopes - test that gentle slopes are walked up and stood on, and steep slopes are not
+func TestMoveSlopes(t *testing.T) {
+	ramp := func(degrees float64) *Geometry {
+		g := flatGround()
+		rise := float32(10 * math.Tan(degrees*math.Pi/180))
+		g.AddLineSegment(segmentFrom(0, 0, 10, rise))
+		return g
+	}
+	start := point.Point{X: -2, Y: 0.51}
+
+	// walking up a 30 degree ramp
+	gentle := ramp(30)
+	c := NewController(NewCircleCollider(0.5), start)
+	var result MoveResult
+	for i := 0; i < 20; i++ {
+		result = c.Move(point.Point{X: 0.25, Y: -0.1}, gentle)
+	}
+	assert.True(t, result.Grounded)
+	assert.Greater(t, result.Position.X, float32(1))
+	assert.InDelta(t, math.Cos(math.Pi/6), result.GroundNormal.Y, 1e-4)
+	assert.InDelta(t, -math.Sin(math.Pi/6), result.GroundNormal.X, 1e-4)
+
+	// standing on the ramp under gravity does not slide downhill
+	before := result.Position
+	for i := 0; i < 10; i++ {
+		result = c.Move(point.Point{X: 0, Y: -0.1}, gentle)
+	}
+	assert.InDelta(t, before.X, result.Position.X, 1e-4)
+	assert.InDelta(t, before.Y, result.Position.Y, 1e-4)
+
+	// a 60 degree ramp is a wall
+	steep := ramp(60)
+	c = NewController(NewCircleCollider(0.5), start)
+	for i := 0; i < 20; i++ {
+		result = c.Move(point.Point{X: 0.25, Y: -0.1}, steep)
+	}
+	assert.Less(t, result.Position.Y, float32(1), "expect character not to climb steep ramp")
+	assert.True(t, result.OnWall)
+}
+
+// TestMoveStepUp - test that a low step is climbed and a high one is not
+func TestMoveStepUp(t *testing.T) {
+	stairs := func(height float32) *Geometry {
+		g := flatGround()
+		g.AddRectangle(rectangle(t, 1, 0, 10, height))
+		return g
+	}
+	walk := func(g *Geometry) (MoveResult, bool) {
+		c := NewController(NewCapsuleCollider(0.5, 2), point.Point{X: 0, Y: 1.01})
+		c.StepHeight = 0.3
+		c.Move(point.Point{X: 0, Y: -0.1}, g)
+		var result MoveResult
+		stepped := false
+		for i := 0; i < 6; i++ {
+			result = c.Move(point.Point{X: 0.5, Y: -0.1}, g)
+			stepped = stepped || result.SteppedUp
+		}
+		return result, stepped
+	}
+	result, stepped := walk(stairs(0.25))
+	assert.True(t, stepped)
+	assert.True(t, result.Grounded)
+	assert.InDelta(t, 1.25+DefaultSkinWidth, result.Position.Y, 1e-5)
+	assert.Greater(t, result.Position.X, float32(2))
+
+	// stopped by the corner of the step, a little above the lower end of the capsule
+	result, stepped = walk(stairs(0.5))
+	assert.False(t, stepped)
+	assert.True(t, result.OnWall)
+	assert.InDelta(t, 0.5-DefaultSkinWidth, result.Position.X, 1e-3)
+}
+
+// TestMoveSnapDown - test that a character walking off a low step stays grounded
+func TestMoveSnapDown(t *testing.T) {
+	g := &Geometry{}
+	g.AddLineSegment(segmentFrom(-10, 0.2, 0, 0.2))
+	g.AddLineSegment(segmentFrom(0, 0, 10, 0))
+	c := NewController(NewCircleCollider(0.5), point.Point{X: -1, Y: 0.71})
+	c.StepHeight = 0.3
+	assert.True(t, c.Move(point.Point{X: 0, Y: -0.1}, g).Grounded)
+	result := c.Move(point.Point{X: 2, Y: 0}, g)
+	assert.True(t, result.Grounded)
+	assert.InDelta(t, 0.5+DefaultSkinWidth, result.Position.Y, 1e-5)
+}
+
+// TestMovePlatform - test that a character standing on a moving platform is carried with it
+func TestMovePlatform(t *testing.T) {
+	g := &Geometry{}
+	lift := NewRectanglePlatform(rectangle(t, -2, -1, 2, 0))
+	g.AddPlatform(lift)
+	c := NewController(NewCircleCollider(0.5), point.Point{X: 0, Y: 0.6})
+	result := c.Move(point.Point{X: 0, Y: -0.1}, g)
+	assert.True(t, result.Grounded)
+	assert.Equal(t, lift, result.Platform)
+
+	// the lift moves right and up, and the character walks left on it
+	lift.Position = point.Point{X: 1, Y: 0.5}
+	result = c.Move(point.Point{X: -0.25, Y: -0.1}, g)
+	assert.True(t, result.Grounded)
+	assert.Equal(t, lift, result.Platform)
+	assert.InDelta(t, 0.75, result.Position.X, 1e-5)
+	assert.InDelta(t, 1+DefaultSkinWidth, result.Position.Y, 1e-5)
+
+	// having jumped off, the character is no longer carried
+	c.Move(point.Point{X: 0, Y: 2}, g)
+	lift.Position = point.Point{X: 5, Y: 0.5}
+	result = c.Move(point.Point{X: 0, Y: 0}, g)
+	assert.False(t, result.Grounded)
+	assert.InDelta(t, 0.75, result.Position.X, 1e-5)
+}
+
+// TestMoveDepenetrate - test that a character starting inside the floor is pushed out of it
+func TestMoveDepenetrate(t *testing.T) {
+	g := &Geometry{}
+	triangle, err := polygon.NewValidatedXYPolygon([]point.Point{{X: -10, Y: -1}, {X: 0, Y: 0}, {X: 10, Y: -1}})
+	assert.Nil(t, err)
+	g.AddPolygon(triangle)
+	c := NewController(NewCircleCollider(0.5), point.Point{X: 0, Y: 0.2})
+	result := c.Move(point.Point{}, g)
+	assert.True(t, result.Grounded)
+	assert.InDelta(t, 0.5, math.Hypot(float64(result.Position.X), float64(result.Position.Y)), 2*DefaultSkinWidth, "expect character to rest on the peak")
+}
@@ -0,0 +1,111 @@
+package character
+
+import (
+	"collision/internal/vec"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+)
+
+// Collider - upright capsule, or a circle when it has no straight sides
+type Collider struct {
+	radius float32 // radius of the rounded ends
+	half   float32 // distance from centre to the centre of each rounded end
+}
+
+// NewCircleCollider - returns a circle collider. If radius is provided as a negative, then abs value is assigned.
+func NewCircleCollider(radius float32) Collider {
+	return Collider{radius: point.Abs(radius)}
+}
+
+// NewCapsuleCollider - returns an upright capsule collider of total height from the bottom of its lower end to
+// the top of its upper end. A height less than twice the radius gives a circle collider.
+func NewCapsuleCollider(radius, height float32) Collider {
+	c := NewCircleCollider(radius)
+	if half := point.Abs(height)/2 - c.radius; half > 0 {
+		c.half = half
+	}
+	return c
+}
+
+// GetRadiusAndHeight - returns radius of the collider's rounded ends and its total height
+func (c Collider) GetRadiusAndHeight() (radius, height float32) {
+	return c.radius, 2 * (c.half + c.radius)
+}
+
+// Geometry - static surfaces and moving platforms that a character moves among. Solid shapes are represented by
+// their outlines, so a character is kept out of them as long as it starts outside.
+type Geometry struct {
+	segments  []segment
+	platforms []*Platform
+}
+
+// AddLineSegment - add a wall, floor or ramp, solid from either side
+func (g *Geometry) AddLineSegment(ls line.LineSegment) {
+	g.segments = append(g.segments, newSegment(ls, nil))
+}
+
+// AddPolygon - add the outline of a polygon
+func (g *Geometry) AddPolygon(p *polygon.XYPolygon) {
+	for _, edge := range ringEdges(p.Vertices) {
+		g.AddLineSegment(edge)
+	}
+}
+
+// AddRectangle - add the outline of an XYRectangle
+func (g *Geometry) AddRectangle(r *polygon.XYRectangle) {
+	for _, edge := range ringEdges(r.Vertices[:]) {
+		g.AddLineSegment(edge)
+	}
+}
+
+// AddPlatform - add a moving platform
+func (g *Geometry) AddPlatform(p *Platform) {
+	g.platforms = append(g.platforms, p)
+}
+
+// all - static segments and platform segments at their current positions
+func (g *Geometry) all() []segment {
+	segments := append([]segment{}, g.segments...)
+	for _, p := range g.platforms {
+		offset := vec.FromPoint(p.Position)
+		for _, edge := range p.edges {
+			s := newSegment(edge, p)
+			s.start, s.end = s.start.Add(offset), s.end.Add(offset)
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+// Platform - surfaces that the caller moves by changing Position. A character standing on a platform is carried
+// with it when it next moves.
+type Platform struct {
+	Position point.Point // offset of the platform from where its edges were given
+	edges    []line.LineSegment
+}
+
+// NewPlatform - returns a pointer to a platform with edges given at its starting position
+func NewPlatform(edges ...line.LineSegment) *Platform {
+	return &Platform{edges: append([]line.LineSegment{}, edges...)}
+}
+
+// NewRectanglePlatform - returns a pointer to a platform with the outline of an XYRectangle at its starting
+// position
+func NewRectanglePlatform(r *polygon.XYRectangle) *Platform {
+	return NewPlatform(ringEdges(r.Vertices[:])...)
+}
+
+// newSegment - segment of geometry in double precision
+func newSegment(ls line.LineSegment, platform *Platform) segment {
+	return segment{start: vec.FromPoint(ls.Start), end: vec.FromPoint(ls.End), platform: platform}
+}
+
+// ringEdges - edges joining each vertex to the next, and the last to the first
+func ringEdges(vertices []point.Point) []line.LineSegment {
+	edges := make([]line.LineSegment, len(vertices))
+	for i := range vertices {
+		edges[i] = line.LineSegment{Start: vertices[i], End: vertices[(i+1)%len(vertices)]}
+	}
+	return edges
+}
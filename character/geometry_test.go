@@ -0,0 +1,39 @@
+package character
+
+import (
+	"collision/internal/vec"
+	"collision/point"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestColliders - test radius and height of circle and capsule colliders
+func TestColliders(t *testing.T) {
+	radius, height := NewCircleCollider(-2).GetRadiusAndHeight()
+	assert.Equal(t, float32(2), radius)
+	assert.Equal(t, float32(4), height)
+
+	c := NewCapsuleCollider(0.5, 3)
+	assert.Equal(t, float32(1), c.half)
+	radius, height = c.GetRadiusAndHeight()
+	assert.Equal(t, float32(0.5), radius)
+	assert.Equal(t, float32(3), height)
+
+	assert.Equal(t, NewCircleCollider(1), NewCapsuleCollider(1, 1), "expect short capsule to be a circle")
+}
+
+// TestGeometryAll - test that shapes are added as their outlines and platforms at their current positions
+func TestGeometryAll(t *testing.T) {
+	g := &Geometry{}
+	g.AddRectangle(rectangle(t, 0, 0, 2, 1))
+	lift := NewPlatform(segmentFrom(0, 0, 1, 0))
+	g.AddPlatform(lift)
+	lift.Position = point.Point{X: 3, Y: 4}
+
+	segments := g.all()
+	assert.Len(t, segments, 5)
+	assert.Equal(t, segment{start: vec.Vec{X: 0, Y: 0}, end: vec.Vec{X: 0, Y: 1}}, segments[0])
+	assert.Equal(t, segment{start: vec.Vec{X: 2, Y: 0}, end: vec.Vec{X: 0, Y: 0}}, segments[3], "expect last edge to close the outline")
+	assert.Equal(t, segment{start: vec.Vec{X: 3, Y: 4}, end: vec.Vec{X: 4, Y: 4}, platform: lift}, segments[4])
+}
@@ -0,0 +1,141 @@
+package character
+
+import (
+	"collision/internal/vec"
+	"math"
+)
+
+// segment - straight piece of geometry in double precision, and the platform it belongs to if any
+type segment struct {
+	start, end vec.Vec
+	platform   *Platform
+}
+
+// hit - first surface met by a moving collider
+type hit struct {
+	fraction float64   // fraction of the displacement made before touching
+	normal   vec.Vec   // unit normal of the surface, pointing towards the collider
+	platform *Platform // platform the surface belongs to, nil for static geometry
+}
+
+// cast - first surface met by a collider with core offsets from centre to each end of half, moved from centre
+// by displacement, and boolean indicating whether one is met before the end of the displacement. A surface is
+// met when the core comes within radius of it. Surfaces the collider already touches only stop it if it moves
+// towards them, so a collider can slide along a surface it rests against.
+//
+// The core meets a segment when the centre enters the parallelogram swept by the segment moved by the core, so
+// the displacement is cast as a ray against that parallelogram inflated by radius: the capsules around its four
+// edges.
+func cast(segments []segment, centre vec.Vec, half float64, radius float64, displacement vec.Vec) (hit, bool) {
+	best, found := hit{fraction: math.Inf(1)}, false
+	offset := vec.Vec{X: 0, Y: half}
+	for _, s := range segments {
+		// corners of segment swept by the core, relative to the centre
+		lowStart, highStart := s.start.Sub(offset), s.start.Add(offset)
+		lowEnd, highEnd := s.end.Sub(offset), s.end.Add(offset)
+		for _, edge := range [4][2]vec.Vec{{lowStart, lowEnd}, {highStart, highEnd}, {lowStart, highStart}, {lowEnd, highEnd}} {
+			fraction, normal, ok := rayCapsule(centre, displacement, edge[0], edge[1], radius)
+			if ok && fraction < best.fraction {
+				best, found = hit{fraction: fraction, normal: normal, platform: s.platform}, true
+			}
+		}
+	}
+	return best, found
+}
+
+// rayCapsule - fraction of displacement from origin at which it first comes within radius of the segment from
+// start to end while approaching it, the unit normal there, and boolean indicating whether it does so
+func rayCapsule(origin, displacement, start, end vec.Vec, radius float64) (float64, vec.Vec, bool) {
+	closest := closestOnSegment(origin, start, end)
+	if away := origin.Sub(closest); away.Length() <= radius {
+		// already touching, which only stops a displacement towards the segment
+		normal := away.Unit()
+		if normal == (vec.Vec{}) {
+			normal = displacement.Scale(-1).Unit()
+		}
+		return 0, normal, displacement.Dot(normal) < 0
+	}
+	best, normal, found := math.Inf(1), vec.Vec{}, false
+	for _, centre := range []vec.Vec{start, end} {
+		if t, ok := rayCircle(origin, displacement, centre, radius); ok && t < best {
+			best, normal, found = t, origin.Add(displacement.Scale(t)).Sub(centre).Unit(), true
+		}
+	}
+	along := end.Sub(start)
+	length := along.Length()
+	if length == 0 {
+		return best, normal, found
+	}
+	u := along.Scale(1 / length)
+	side := u.LeftNormal()
+	if origin.Sub(start).Dot(side) < 0 {
+		side = side.Scale(-1)
+	}
+	if approach := displacement.Dot(side); approach < 0 {
+		t := (radius - origin.Sub(start).Dot(side)) / approach
+		if s := origin.Add(displacement.Scale(t)).Sub(start).Dot(u); t >= 0 && t <= 1 && s >= 0 && s <= length && t < best {
+			best, normal, found = t, side, true
+		}
+	}
+	return best, normal, found
+}
+
+// rayCircle - fraction of displacement from origin, which is outside the circle, at which it enters the circle,
+// and boolean indicating whether it does so before the end of the displacement
+func rayCircle(origin, displacement, centre vec.Vec, radius float64) (float64, bool) {
+	m := origin.Sub(centre)
+	a, b, c := displacement.Dot(displacement), m.Dot(displacement), m.Dot(m)-radius*radius
+	if a == 0 || b >= 0 {
+		return 0, false
+	}
+	discriminant := b*b - a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+	t := (-b - math.Sqrt(discriminant)) / a
+	return math.Max(t, 0), t <= 1
+}
+
+// closestOnSegment - point of the segment from start to end nearest to p
+func closestOnSegment(p, start, end vec.Vec) vec.Vec {
+	along := end.Sub(start)
+	lengthSquared := along.Dot(along)
+	if lengthSquared == 0 {
+		return start
+	}
+	t := math.Max(0, math.Min(1, p.Sub(start).Dot(along)/lengthSquared))
+	return start.Add(along.Scale(t))
+}
+
+// closestBetweenSegments - nearest points of segments p1 to q1 and p2 to q2, from the parameters that minimise
+// the distance between them clamped to the segments
+func closestBetweenSegments(p1, q1, p2, q2 vec.Vec) (onFirst, onSecond vec.Vec) {
+	d1, d2, r := q1.Sub(p1), q2.Sub(p2), p1.Sub(p2)
+	a, e, f := d1.Dot(d1), d2.Dot(d2), d2.Dot(r)
+	var s, t float64
+	switch {
+	case a == 0 && e == 0:
+		return p1, p2
+	case a == 0:
+		t = clamp(f / e)
+	case e == 0:
+		s = clamp(-d1.Dot(r) / a)
+	default:
+		b, c := d1.Dot(d2), d1.Dot(r)
+		if denominator := a*e - b*b; denominator != 0 {
+			s = clamp((b*f - c*e) / denominator)
+		}
+		t = (b*s + f) / e
+		if t < 0 {
+			t, s = 0, clamp(-c/a)
+		} else if t > 1 {
+			t, s = 1, clamp((b-c)/a)
+		}
+	}
+	return p1.Add(d1.Scale(s)), p2.Add(d2.Scale(t))
+}
+
+// clamp - value limited to the range 0 to 1
+func clamp(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,78 @@
+package character
+
+import (
+	"collision/internal/vec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRayCapsule - test first contact of a moving point with a capsule around a segment
+func TestRayCapsule(t *testing.T) {
+	start, end := vec.Vec{X: 0, Y: 0}, vec.Vec{X: 4, Y: 0}
+
+	// straight down onto the side
+	fraction, normal, ok := rayCapsule(vec.Vec{X: 2, Y: 3}, vec.Vec{X: 0, Y: -4}, start, end, 1)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, fraction, 1e-12)
+	assert.Equal(t, vec.Vec{X: 0, Y: 1}, normal)
+
+	// along the axis into the rounded end
+	fraction, normal, ok = rayCapsule(vec.Vec{X: 8, Y: 0}, vec.Vec{X: -4, Y: 0}, start, end, 1)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.75, fraction, 1e-12)
+	assert.Equal(t, vec.Vec{X: 1, Y: 0}, normal)
+
+	// falling short, passing by and moving away
+	_, _, ok = rayCapsule(vec.Vec{X: 2, Y: 3}, vec.Vec{X: 0, Y: -1}, start, end, 1)
+	assert.False(t, ok)
+	_, _, ok = rayCapsule(vec.Vec{X: -3, Y: 3}, vec.Vec{X: 0, Y: -6}, start, end, 1)
+	assert.False(t, ok)
+	_, _, ok = rayCapsule(vec.Vec{X: 2, Y: 3}, vec.Vec{X: 0, Y: 1}, start, end, 1)
+	assert.False(t, ok)
+
+	// already touching, stopped only when moving towards the segment
+	fraction, normal, ok = rayCapsule(vec.Vec{X: 2, Y: 1}, vec.Vec{X: 0, Y: -1}, start, end, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, fraction)
+	assert.Equal(t, vec.Vec{X: 0, Y: 1}, normal)
+	_, _, ok = rayCapsule(vec.Vec{X: 2, Y: 1}, vec.Vec{X: 1, Y: 0}, start, end, 1)
+	assert.False(t, ok, "expect sliding along the segment not to be stopped")
+}
+
+// TestCast - test that a capsule collider is stopped by whichever part of it meets a segment first
+func TestCast(t *testing.T) {
+	ledge := []segment{{start: vec.Vec{X: 1, Y: 1}, end: vec.Vec{X: 5, Y: 1}}}
+	// capsule with rounded ends centred 1 above and below its centre meets the ledge with its upper end
+	h, ok := cast(ledge, vec.Vec{X: -2, Y: 1}, 1, 0.5, vec.Vec{X: 4, Y: 0})
+	assert.True(t, ok)
+	assert.InDelta(t, 2.5/4, h.fraction, 1e-12, "expect side of capsule to reach the end of the ledge")
+	assert.Equal(t, vec.Vec{X: -1, Y: 0}, h.normal)
+
+	// rising into the ledge from below
+	h, ok = cast(ledge, vec.Vec{X: 3, Y: -2}, 1, 0.5, vec.Vec{X: 0, Y: 4})
+	assert.True(t, ok)
+	assert.InDelta(t, 1.5/4, h.fraction, 1e-12)
+	assert.Equal(t, vec.Vec{X: 0, Y: -1}, h.normal)
+
+	_, ok = cast(ledge, vec.Vec{X: 3, Y: 3}, 1, 0.5, vec.Vec{X: 2, Y: 0})
+	assert.False(t, ok)
+}
+
+// TestClosestBetweenSegments - test crossing, parallel and skew segments
+func TestClosestBetweenSegments(t *testing.T) {
+	a, b := closestBetweenSegments(vec.Vec{X: 0, Y: 0}, vec.Vec{X: 4, Y: 4}, vec.Vec{X: 0, Y: 4}, vec.Vec{X: 4, Y: 0})
+	assert.Equal(t, vec.Vec{X: 2, Y: 2}, a)
+	assert.Equal(t, vec.Vec{X: 2, Y: 2}, b)
+
+	a, b = closestBetweenSegments(vec.Vec{X: 0, Y: 0}, vec.Vec{X: 4, Y: 0}, vec.Vec{X: 6, Y: 1}, vec.Vec{X: 6, Y: 5})
+	assert.Equal(t, vec.Vec{X: 4, Y: 0}, a)
+	assert.Equal(t, vec.Vec{X: 6, Y: 1}, b)
+
+	a, b = closestBetweenSegments(vec.Vec{X: 0, Y: 0}, vec.Vec{X: 4, Y: 0}, vec.Vec{X: 1, Y: 2}, vec.Vec{X: 3, Y: 2})
+	assert.Equal(t, 2.0, b.Sub(a).Length(), "expect parallel segments to be their separation apart")
+
+	a, b = closestBetweenSegments(vec.Vec{X: 1, Y: 1}, vec.Vec{X: 1, Y: 1}, vec.Vec{X: 0, Y: 0}, vec.Vec{X: 2, Y: 0})
+	assert.Equal(t, vec.Vec{X: 1, Y: 1}, a)
+	assert.Equal(t, vec.Vec{X: 1, Y: 0}, b)
+}
@@ -0,0 +1,146 @@
+package circle
+
+import (
+	"collision/internal/vec"
+	"collision/line"
+	"collision/point"
+	"math"
+)
+
+// FullTurn - angle of a full turn in radians
+const FullTurn = 2 * math.Pi
+
+// Arc - part of the circumference of a circle, running counterclockwise from a start angle to an end angle.
+// Angles are in radians, counterclockwise from the positive x axis.
+type Arc struct {
+	centre point.Point // point at centre of arc
+	radius float32     // radius of arc
+	start  float64     // angle of start of arc, in range 0 to FullTurn
+	sweep  float64     // counterclockwise angle from start to end of arc, greater than 0 and at most FullTurn
+}
+
+// NewArc - returns a new arc running counterclockwise from startAngle to endAngle. If radius is provided as a
+// negative, then abs value is assigned. An end angle equal to the start angle, or a whole number of turns from
+// it, gives a full circle.
+func NewArc(centre point.Point, radius, startAngle, endAngle float32) Arc {
+	start, sweep := normaliseAngles(startAngle, endAngle)
+	return Arc{centre: centre, radius: point.Abs(radius), start: start, sweep: sweep}
+}
+
+// GetCentreAndRadius - returns a point indicating centre and a float32 indicating radius of an arc
+func (a Arc) GetCentreAndRadius() (centre point.Point, radius float32) {
+	return a.centre, a.radius
+}
+
+// GetAngles - returns start and end angles of an arc, with the start in the range 0 to FullTurn and the end
+// greater than the start by at most FullTurn
+func (a Arc) GetAngles() (startAngle, endAngle float32) {
+	return float32(a.start), float32(a.start + a.sweep)
+}
+
+// Ends - returns the points at the start and end of an arc
+func (a Arc) Ends() (start, end point.Point) {
+	return pointAtAngle(a.centre, float64(a.radius), a.start), pointAtAngle(a.centre, float64(a.radius), a.start+a.sweep)
+}
+
+// HasPoint - returns boolean indicating whether point lies on arc, using global delta
+func (a Arc) HasPoint(p point.Point) bool {
+	return a.HasPointWithTolerance(p, point.DefaultTolerance)
+}
+
+// HasPointWithTolerance - returns boolean indicating whether point lies on arc, under the tolerance
+func (a Arc) HasPointWithTolerance(p point.Point, tol point.Tolerance) bool {
+	if !NewCircleFromPoint(a.centre, a.radius).CircumferenceTouchesPointWithTolerance(p, tol) {
+		return false
+	}
+	return angleWithin(a.start, a.sweep, angleOf(a.centre, p))
+}
+
+// LineSegmentIntersectionPoints - returns the points at which a line segment meets an arc, in order along the
+// segment from its start. Tangency is decided using global delta.
+func (a Arc) LineSegmentIntersectionPoints(ls line.LineSegment) []point.Point {
+	var points []point.Point
+	for _, p := range NewCircleFromPoint(a.centre, a.radius).LineSegmentIntersectionPoints(ls) {
+		if angleWithin(a.start, a.sweep, angleOf(a.centre, p)) {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// IntersectsCircle - returns boolean indicating whether any point of an arc lies in the circle
+func (a Arc) IntersectsCircle(c Circle) bool {
+	return a.distanceToPoint(c.centre) <= float64(c.radius)
+}
+
+// DistanceToPoint - returns distance from point to nearest point of arc
+func (a Arc) DistanceToPoint(p point.Point) float32 {
+	return float32(a.distanceToPoint(p))
+}
+
+// distanceToPoint - distance from point to nearest point of arc, in double precision
+func (a Arc) distanceToPoint(p point.Point) float64 {
+	dx, dy := float64(p.X)-float64(a.centre.X), float64(p.Y)-float64(a.centre.Y)
+	fromCentre := math.Hypot(dx, dy)
+	if fromCentre == 0 || angleWithin(a.start, a.sweep, math.Atan2(dy, dx)) {
+		// nearest point is on the ray from centre through p
+		return math.Abs(fromCentre - float64(a.radius))
+	}
+	start, end := a.Ends()
+	return math.Min(vec.Distance(p, start), vec.Distance(p, end))
+}
+
+// normaliseAngles - start angle in the range 0 to FullTurn and counterclockwise sweep to end angle, greater
+// than 0 and at most FullTurn. A sweep within a float32 ulp of the angles of a whole number of turns is a full
+// turn, since 2π and its multiples are rounded when stored as float32.
+func normaliseAngles(startAngle, endAngle float32) (start, sweep float64) {
+	start = normaliseAngle(float64(startAngle))
+	sweep = normaliseAngle(float64(endAngle) - float64(startAngle))
+	ulp := math.Max(ulp32(startAngle), ulp32(endAngle))
+	if sweep <= ulp || sweep >= FullTurn-ulp {
+		sweep = FullTurn
+	}
+	return start, sweep
+}
+
+// ulp32 - gap between the magnitude of a float32 and the next float32 up
+func ulp32(f float32) float64 {
+	f = point.Abs(f)
+	return float64(math.Nextafter32(f, float32(math.Inf(1)))) - float64(f)
+}
+
+// normaliseAngle - equivalent angle in the range 0 to FullTurn
+func normaliseAngle(angle float64) float64 {
+	angle = math.Mod(angle, FullTurn)
+	if angle < 0 {
+		angle += FullTurn
+	}
+	if angle >= FullTurn {
+		angle = 0
+	}
+	return angle
+}
+
+// angleWithin - boolean indicating whether angle lies in the counterclockwise sweep from start. A small
+// allowance is made for round-off in angles computed from coordinates.
+func angleWithin(start, sweep, angle float64) bool {
+	const allowance = 1e-9
+	if sweep >= FullTurn-allowance {
+		return true
+	}
+	offset := normaliseAngle(angle - start)
+	return offset <= sweep+allowance || offset >= FullTurn-allowance
+}
+
+// angleOf - angle of point from centre
+func angleOf(centre, p point.Point) float64 {
+	return math.Atan2(float64(p.Y)-float64(centre.Y), float64(p.X)-float64(centre.X))
+}
+
+// pointAtAngle - point at distance from centre, at angle
+func pointAtAngle(centre point.Point, distance, angle float64) point.Point {
+	return point.Point{
+		X: float32(float64(centre.X) + distance*math.Cos(angle)),
+		Y: float32(float64(centre.Y) + distance*math.Sin(angle)),
+	}
+}
@@ -0,0 +1,99 @@
+package circle
+
+import (
+	"collision/line"
+	"collision/point"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewArc - test that NewArc normalises angles as expected
+func TestNewArc(t *testing.T) {
+	arc := NewArc(point.Point{X: 1, Y: 2}, -3, -math.Pi/2, math.Pi/2)
+	centre, radius := arc.GetCentreAndRadius()
+	assert.Equal(t, point.Point{X: 1, Y: 2}, centre)
+	assert.Equal(t, float32(3), radius, "radius should be three")
+	start, end := arc.GetAngles()
+	assert.InDelta(t, 3*math.Pi/2, start, 1e-6, "expect start in range 0 to full turn")
+	assert.InDelta(t, 5*math.Pi/2, end, 1e-6, "expect end after start")
+
+	// end before start wraps around
+	start, end = NewArc(point.Point{}, 1, math.Pi, math.Pi/2).GetAngles()
+	assert.InDelta(t, math.Pi, start, 1e-6)
+	assert.InDelta(t, 5*math.Pi/2, end, 1e-6)
+
+	// equal angles give full circle
+	start, end = NewArc(point.Point{}, 1, 1, 1).GetAngles()
+	assert.InDelta(t, FullTurn, end-start, 1e-6)
+
+	first, last := NewArc(point.Point{}, 2, 0, math.Pi/2).Ends()
+	assert.Equal(t, point.Point{X: 2, Y: 0}, first)
+	assert.InDelta(t, 0, last.X, 1e-6)
+	assert.InDelta(t, 2, last.Y, 1e-6)
+}
+
+// TestNewArcFullTurn - test that whole turns written as float32 multiples of 2π give a full circle, though
+// the constants are rounded when stored
+func TestNewArcFullTurn(t *testing.T) {
+	full := NewArc(point.Point{}, 1, 0, 2*math.Pi)
+	start, end := full.GetAngles()
+	assert.Equal(t, float32(0), start)
+	assert.InDelta(t, FullTurn, end, 1e-6)
+	assert.True(t, full.HasPoint(point.Point{X: 0, Y: 1}))
+
+	// a full arc's own angles give the full arc back
+	start, end = NewArc(point.Point{}, 1, 1, 1).GetAngles()
+	assert.Equal(t, float32(7.2831855), end)
+	again := NewArc(point.Point{}, 1, start, end)
+	start, end = again.GetAngles()
+	assert.InDelta(t, FullTurn, end-start, 1e-6)
+	assert.True(t, again.HasPoint(point.Point{X: -1, Y: 0}))
+
+	start, end = NewArc(point.Point{}, 1, -math.Pi, 3*math.Pi).GetAngles()
+	assert.InDelta(t, FullTurn, end-start, 1e-6, "expect two turns to give a full circle")
+
+	// an arc short of a full turn by more than round-off stays short
+	start, end = NewArc(point.Point{}, 1, 0, 2*math.Pi-1e-5).GetAngles()
+	assert.InDelta(t, FullTurn-1e-5, end-start, 1e-6)
+}
+
+// TestArcHasPoint - test that HasPoint behaves as expected
+func TestArcHasPoint(t *testing.T) {
+	// upper half of circle
+	arc := NewArc(point.Point{}, 5, 0, math.Pi)
+	assert.True(t, arc.HasPoint(point.Point{X: 3, Y: 4}))
+	assert.True(t, arc.HasPoint(point.Point{X: -5, Y: 0}), "expect end of arc")
+	assert.False(t, arc.HasPoint(point.Point{X: 3, Y: -4}), "expect point on other half of circle")
+	assert.False(t, arc.HasPoint(point.Point{X: 0, Y: 4}), "expect point inside circle")
+	assert.True(t, arc.HasPointWithTolerance(point.Point{X: 0, Y: 4.99}, point.NewTolerance(0.1, 0)))
+}
+
+// TestArcLineSegmentIntersectionPoints - test that LineSegmentIntersectionPoints keeps points on arc
+func TestArcLineSegmentIntersectionPoints(t *testing.T) {
+	// arc wrapping through angle zero, on right of circle
+	arc := NewArc(point.Point{}, 5, -math.Pi/2, math.Pi/2)
+	ls := line.LineSegment{Start: point.Point{X: -10, Y: 3}, End: point.Point{X: 10, Y: 3}}
+	assert.Equal(t, []point.Point{{X: 4, Y: 3}}, arc.LineSegmentIntersectionPoints(ls), "expect only right crossing")
+
+	ls = line.LineSegment{Start: point.Point{X: 4, Y: -10}, End: point.Point{X: 4, Y: 10}}
+	assert.Equal(t, []point.Point{{X: 4, Y: -3}, {X: 4, Y: 3}}, arc.LineSegmentIntersectionPoints(ls))
+
+	ls = line.LineSegment{Start: point.Point{X: -4, Y: -10}, End: point.Point{X: -4, Y: 10}}
+	assert.Empty(t, arc.LineSegmentIntersectionPoints(ls))
+}
+
+// TestArcIntersectsCircle - test that IntersectsCircle and DistanceToPoint behave as expected
+func TestArcIntersectsCircle(t *testing.T) {
+	// quarter arc in first quadrant
+	arc := NewArc(point.Point{}, 5, 0, math.Pi/2)
+	assert.InDelta(t, 5, arc.DistanceToPoint(point.Point{}), 1e-6, "expect radius from centre")
+	assert.InDelta(t, 5, arc.DistanceToPoint(point.Point{X: 6, Y: 8}), 1e-6, "expect radial distance")
+	assert.InDelta(t, 5, arc.DistanceToPoint(point.Point{X: 5, Y: -5}), 1e-6, "expect distance to end")
+
+	assert.True(t, arc.IntersectsCircle(NewCircle(6, 8, 5)), "expect circle touching arc")
+	assert.False(t, arc.IntersectsCircle(NewCircle(0, 0, 4)), "expect circle inside arc not to reach it")
+	assert.False(t, arc.IntersectsCircle(NewCircle(-4, -3, 1)), "expect circle by other part of circumference")
+	assert.True(t, arc.IntersectsCircle(NewCircle(5, -1, 1)), "expect circle touching end")
+}
@@ -0,0 +1,44 @@
+package circle
+
+import (
+	"collision/point"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// ErrInvalidBinary - returned when binary data does not encode a circle
+var ErrInvalidBinary = errors.New("binary data is not a circle")
+
+// BinarySize - number of bytes in the binary encoding of a circle
+const BinarySize = point.BinarySize + 4
+
+// MarshalBinary - encode circle as its centre followed by the IEEE 754 bits of its radius, little endian. The
+// encoding is exact, so a decoded circle is bit for bit the circle encoded.
+func (c Circle) MarshalBinary() ([]byte, error) {
+	return c.AppendBinary(make([]byte, 0, BinarySize)), nil
+}
+
+// AppendBinary - append the binary encoding of circle to b and return the extended slice
+func (c Circle) AppendBinary(b []byte) []byte {
+	b = c.centre.AppendBinary(b)
+	return binary.LittleEndian.AppendUint32(b, math.Float32bits(c.radius))
+}
+
+// UnmarshalBinary - decode circle from data made by MarshalBinary. Returns ErrInvalidBinary if data is not
+// BinarySize bytes long or the radius is negative or not a number.
+func (c *Circle) UnmarshalBinary(data []byte) error {
+	if len(data) != BinarySize {
+		return ErrInvalidBinary
+	}
+	var centre point.Point
+	if err := centre.UnmarshalBinary(data[:point.BinarySize]); err != nil {
+		return ErrInvalidBinary
+	}
+	radius := math.Float32frombits(binary.LittleEndian.Uint32(data[point.BinarySize:]))
+	if !(radius >= 0) {
+		return ErrInvalidBinary
+	}
+	c.centre, c.radius = centre, radius
+	return nil
+}
@@ -0,0 +1,28 @@
+package circle
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCircleBinary - test that circles survive a round trip through their binary encoding, and that invalid data
+// is rejected
+func TestCircleBinary(t *testing.T) {
+	c := NewCircle(0.1, -7.3, 2.5)
+	data, err := c.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Len(t, data, BinarySize)
+	var decoded Circle
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, c, decoded)
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[1:]), ErrInvalidBinary)
+	negative := NewCircle(0, 0, 1).AppendBinary(nil)
+	negative[BinarySize-1] |= 0x80
+	assert.ErrorIs(t, decoded.UnmarshalBinary(negative), ErrInvalidBinary)
+	notANumber := NewCircle(float32(math.NaN()), 0, float32(math.NaN())).AppendBinary(nil)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(notANumber), ErrInvalidBinary)
+	assert.Equal(t, c, decoded, "expect circle unchanged by failed decoding")
+}
@@ -35,10 +35,23 @@ func (c Circle) ContainsPoint(p point.Point) bool {
 	return distanceFromCentre <= c.radius
 }
 
+// ContainsPointWithTolerance - returns boolean indicating whether a point is inside a circle, or on its
+// circumference under the tolerance
+func (c Circle) ContainsPointWithTolerance(p point.Point, tol point.Tolerance) bool {
+	distanceFromCentre := c.centre.Distance(p)
+	return distanceFromCentre <= c.radius || tol.AreWithin(distanceFromCentre, c.radius)
+}
+
 // CircumferenceTouchesPoint - returns boolean indicating whether a point lies on circumference of circle
 func (c Circle) CircumferenceTouchesPoint(p point.Point) bool {
+	return c.CircumferenceTouchesPointWithTolerance(p, point.DefaultTolerance)
+}
+
+// CircumferenceTouchesPointWithTolerance - returns boolean indicating whether a point lies on circumference
+// of circle under the tolerance
+func (c Circle) CircumferenceTouchesPointWithTolerance(p point.Point, tol point.Tolerance) bool {
 	distanceFromCentre := c.centre.Distance(p)
-	return point.Abs(distanceFromCentre-c.radius) < point.Delta
+	return tol.AreWithin(distanceFromCentre, c.radius)
 }
 
 // CirclesIntersect - returns boolean indicating whether two circles intersect
@@ -49,6 +62,14 @@ func (c Circle) CirclesIntersect(d Circle) bool {
 	return distanceBetweenCentres <= sumOfRadii
 }
 
+// CirclesIntersectWithTolerance - returns boolean indicating whether two circles intersect, treating circles
+// that touch at one point under the tolerance as intersecting
+func (c Circle) CirclesIntersectWithTolerance(d Circle, tol point.Tolerance) bool {
+	sumOfRadii := c.radius + d.radius
+	distanceBetweenCentres := c.centre.Distance(d.centre)
+	return distanceBetweenCentres <= sumOfRadii || tol.AreWithin(distanceBetweenCentres, sumOfRadii)
+}
+
 // InstersectsLineSegment - returns boolean indicating whether circle intersects line segment
 func (c Circle) InstersectsLineSegment(ls line.LineSegment) bool {
 	// check if start or end of line segment are in circle as this would imply intersection
@@ -56,13 +77,27 @@ func (c Circle) InstersectsLineSegment(ls line.LineSegment) bool {
 		return true
 	}
 	// if neither are inside, find closest point on line (not segment) and check this
-	return c.intersectsLineSegmentByCheckingClosestPoint(ls)
+	return c.intersectsLineSegmentByCheckingClosestPoint(ls, c.ContainsPoint, ls.HasPoint)
+}
 
+// InstersectsLineSegmentWithTolerance - returns boolean indicating whether circle intersects line segment,
+// with every near equality decision made under the tolerance
+func (c Circle) InstersectsLineSegmentWithTolerance(ls line.LineSegment, tol point.Tolerance) bool {
+	containsPoint := func(p point.Point) bool {
+		return c.ContainsPointWithTolerance(p, tol)
+	}
+	if containsPoint(ls.Start) || containsPoint(ls.End) {
+		return true
+	}
+	hasPoint := func(p point.Point) bool {
+		return ls.HasPointWithTolerance(p, tol)
+	}
+	return c.intersectsLineSegmentByCheckingClosestPoint(ls, containsPoint, hasPoint)
 }
 
 // intersectsLineSegmentByCheckingClosestPoint - find closest point on line segment to the circle and then check
 // whether the closest point satisfies line intersecting circle
-func (c Circle) intersectsLineSegmentByCheckingClosestPoint(ls line.LineSegment) bool {
+func (c Circle) intersectsLineSegmentByCheckingClosestPoint(ls line.LineSegment, containsPoint, hasPoint func(point.Point) bool) bool {
 	length := ls.Length()
 	dotProduct := (((c.centre.X-ls.Start.X)*(ls.End.X-ls.Start.X) + (c.centre.Y-ls.Start.Y)*(ls.End.Y-ls.Start.Y)) / (length * length))
 
@@ -72,10 +107,10 @@ func (c Circle) intersectsLineSegmentByCheckingClosestPoint(ls line.LineSegment)
 	closestPoint := point.Point{X: xClosest, Y: yClosest}
 
 	// check if closest point is in circle - if it doesn't, then they cannot intersect
-	if !c.ContainsPoint(closestPoint) {
+	if !containsPoint(closestPoint) {
 		return false
 	}
 
 	// if at this point, then check if closest point is on line segment
-	return ls.HasPoint(closestPoint)
+	return hasPoint(closestPoint)
 }
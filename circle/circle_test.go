@@ -224,3 +224,22 @@ func TestIntersectsLineSegment(t *testing.T) {
 	ok = c.InstersectsLineSegment(ls)
 	assert.False(t, ok, "line segment should not intersect circle")
 }
+
+// TestWithTolerance - test that tolerance variants treat near misses as hits
+func TestWithTolerance(t *testing.T) {
+	c := Circle{centre: point.Point{X: 0, Y: 0}, radius: 10}
+	tol := point.NewTolerance(0.01, 0)
+
+	p := point.Point{X: 10.005, Y: 0}
+	assert.False(t, c.ContainsPoint(p), "point should be outside circle")
+	assert.True(t, c.ContainsPointWithTolerance(p, tol), "point should be inside circle under tolerance")
+	assert.True(t, c.CircumferenceTouchesPointWithTolerance(p, tol), "circumference should touch point under tolerance")
+
+	d := Circle{centre: point.Point{X: 20.005, Y: 0}, radius: 10}
+	assert.False(t, c.CirclesIntersect(d), "circles should not intersect")
+	assert.True(t, c.CirclesIntersectWithTolerance(d, tol), "circles should intersect under tolerance")
+
+	ls := line.LineSegment{Start: point.Point{X: 10.005, Y: 50}, End: point.Point{X: 10.005, Y: -100}}
+	assert.False(t, c.InstersectsLineSegment(ls), "line segment should not intersect circle")
+	assert.True(t, c.InstersectsLineSegmentWithTolerance(ls, tol), "line segment should intersect circle under tolerance")
+}
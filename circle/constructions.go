@@ -0,0 +1,143 @@
+package circle
+
+import (
+	"collision/line"
+	"collision/point"
+	"collision/robust"
+	"errors"
+	"math"
+)
+
+// errors returned by constructions that have no solution
+var (
+	ErrCollinear  = errors.New("points are collinear")
+	ErrConcentric = errors.New("circles are concentric")
+)
+
+// TangentsFromPoint - returns the two tangents to the circle from an external point, as line segments from the
+// point to where each touches the circle. The first touches the circle on the right when looking from the point
+// to the centre. Points inside the circle or on its circumference, using global delta, have no tangents.
+func (c Circle) TangentsFromPoint(p point.Point) []line.LineSegment {
+	if c.ContainsPointWithTolerance(p, point.DefaultTolerance) {
+		return nil
+	}
+	// tangent points are radius from centre, normal to the tangent line
+	normals := tangentNormals(c.centre, p, float64(c.radius))
+	tangents := make([]line.LineSegment, len(normals))
+	for i, n := range normals {
+		tangents[i] = line.LineSegment{Start: p, End: c.pointInDirection(n[0], n[1])}
+	}
+	return tangents
+}
+
+// CommonTangents - returns the common tangents of two circles, as line segments from where each touches c to
+// where it touches d. The external tangents, which do not pass between the circles, come first, followed by
+// the internal tangents, which do. Separate circles have four common tangents. Circles touching from outside
+// have three, the third touching both at the same point, and crossing circles have two. Circles touching from
+// inside have one and circles inside one another have none. Touching is decided using global delta.
+func (c Circle) CommonTangents(d Circle) []line.LineSegment {
+	_, relation := c.CircleIntersectionPoints(d)
+	if relation == CirclesCoincident {
+		return nil
+	}
+	var tangents []line.LineSegment
+	// external tangents touch both circles on the same side, internal tangents on opposite sides
+	for _, side := range []float64{1, -1} {
+		if side == -1 && relation != CirclesSeparate && relation != CirclesTouching {
+			// internal tangents only exist when circles do not overlap
+			break
+		}
+		for _, n := range tangentNormals(c.centre, d.centre, float64(c.radius)-side*float64(d.radius)) {
+			onC := c.pointInDirection(n[0], n[1])
+			onD := d.pointInDirection(side*n[0], side*n[1])
+			if side == -1 && relation == CirclesTouching {
+				// the single internal tangent passes through the point where the circles touch
+				onD = onC
+			}
+			tangents = append(tangents, line.LineSegment{Start: onC, End: onD})
+		}
+	}
+	return tangents
+}
+
+// tangentNormals - unit normals n of the lines whose signed distance from centre is offset and which pass
+// through p, that is those with n·(p - centre) = offset. There are two, or one if p is at distance offset.
+func tangentNormals(centre, p point.Point, offset float64) [][2]float64 {
+	dx, dy := float64(p.X)-float64(centre.X), float64(p.Y)-float64(centre.Y)
+	distance := math.Hypot(dx, dy)
+	if distance == 0 {
+		return nil
+	}
+	ux, uy := dx/distance, dy/distance
+	cosine := offset / distance
+	if point.DefaultTolerance.AreWithin(float32(math.Abs(offset)), float32(distance)) {
+		return [][2]float64{{ux * math.Copysign(1, cosine), uy * math.Copysign(1, cosine)}}
+	}
+	if math.Abs(cosine) > 1 {
+		return nil
+	}
+	sine := math.Sqrt(1 - cosine*cosine)
+	return [][2]float64{
+		{ux*cosine - uy*sine, uy*cosine + ux*sine},
+		{ux*cosine + uy*sine, uy*cosine - ux*sine},
+	}
+}
+
+// pointInDirection - point on circumference in the direction of the unit vector (x, y) from the centre
+func (c Circle) pointInDirection(x, y float64) point.Point {
+	radius := float64(c.radius)
+	return point.Point{X: float32(float64(c.centre.X) + x*radius), Y: float32(float64(c.centre.Y) + y*radius)}
+}
+
+// NewCircumcircle - returns the circle passing through three points, or ErrCollinear if the points are
+// collinear, decided exactly
+func NewCircumcircle(a, b, c point.Point) (Circle, error) {
+	if robust.Orient(a, b, c) == robust.Collinear {
+		return Circle{}, ErrCollinear
+	}
+	// centre relative to a is equidistant from a, b and c
+	bx, by := float64(b.X)-float64(a.X), float64(b.Y)-float64(a.Y)
+	cx, cy := float64(c.X)-float64(a.X), float64(c.Y)-float64(a.Y)
+	denominator := 2 * (bx*cy - by*cx)
+	bLengthSquared, cLengthSquared := bx*bx+by*by, cx*cx+cy*cy
+	ux := (cy*bLengthSquared - by*cLengthSquared) / denominator
+	uy := (bx*cLengthSquared - cx*bLengthSquared) / denominator
+	centre := point.Point{X: float32(float64(a.X) + ux), Y: float32(float64(a.Y) + uy)}
+	return NewCircleFromPoint(centre, float32(math.Hypot(ux, uy))), nil
+}
+
+// NewIncircle - returns the largest circle inside the triangle abc, touching each of its sides, or
+// ErrCollinear if the points are collinear, decided exactly
+func NewIncircle(a, b, c point.Point) (Circle, error) {
+	if robust.Orient(a, b, c) == robust.Collinear {
+		return Circle{}, ErrCollinear
+	}
+	// centre is the average of the vertices, each weighted by the length of the opposite side
+	ax, ay, bx, by, cx, cy := float64(a.X), float64(a.Y), float64(b.X), float64(b.Y), float64(c.X), float64(c.Y)
+	oppositeA, oppositeB, oppositeC := math.Hypot(cx-bx, cy-by), math.Hypot(ax-cx, ay-cy), math.Hypot(bx-ax, by-ay)
+	perimeter := oppositeA + oppositeB + oppositeC
+	centre := point.Point{
+		X: float32((oppositeA*ax + oppositeB*bx + oppositeC*cx) / perimeter),
+		Y: float32((oppositeA*ay + oppositeB*by + oppositeC*cy) / perimeter),
+	}
+	area := math.Abs((bx-ax)*(cy-ay)-(cx-ax)*(by-ay)) / 2
+	return NewCircleFromPoint(centre, float32(2*area/perimeter)), nil
+}
+
+// RadicalAxis - returns the radical axis of two circles, the line of points with equal tangent lengths to
+// both, as a line segment of unit length along it. The segment starts where the axis crosses the line of
+// centres and turns left from it. The axis of crossing circles passes through both intersection points, and
+// that of touching circles is their common tangent where they touch. Concentric circles have no radical axis.
+func (c Circle) RadicalAxis(d Circle) (line.LineSegment, error) {
+	cx, cy, r0 := float64(c.centre.X), float64(c.centre.Y), float64(c.radius)
+	dx, dy, r1 := float64(d.centre.X)-cx, float64(d.centre.Y)-cy, float64(d.radius)
+	distance := math.Hypot(dx, dy)
+	if distance == 0 {
+		return line.LineSegment{}, ErrConcentric
+	}
+	ux, uy := dx/distance, dy/distance
+	along := (distance*distance + r0*r0 - r1*r1) / (2 * distance)
+	start := point.Point{X: float32(cx + ux*along), Y: float32(cy + uy*along)}
+	end := point.Point{X: float32(cx + ux*along - uy), Y: float32(cy + uy*along + ux)}
+	return line.LineSegment{Start: start, End: end}, nil
+}
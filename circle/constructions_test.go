@@ -0,0 +1,119 @@
+package circle
+
+import (
+	"collision/line"
+	"collision/point"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertTangent - assert that a line segment touches the circle at its end or start
+func assertTangent(t *testing.T, c Circle, ls line.LineSegment, touching point.Point, msg string) {
+	assert.True(t, c.CircumferenceTouchesPointWithTolerance(touching, point.EasyTolerance), msg)
+	radiusX, radiusY := touching.X-c.centre.X, touching.Y-c.centre.Y
+	directionX, directionY := ls.End.X-ls.Start.X, ls.End.Y-ls.Start.Y
+	assert.InDelta(t, 0, radiusX*directionX+radiusY*directionY, 1e-4, msg+": expect radius perpendicular to tangent")
+}
+
+// TestTangentsFromPoint - test that TangentsFromPoint behaves as expected
+func TestTangentsFromPoint(t *testing.T) {
+	c := NewCircle(0, 0, 3)
+	tangents := c.TangentsFromPoint(point.Point{X: 5, Y: 0})
+	assert.Len(t, tangents, 2)
+	// tangent length is 4 for a 3-4-5 triangle
+	assert.InDelta(t, 1.8, tangents[0].End.X, 1e-5)
+	assert.InDelta(t, 2.4, tangents[0].End.Y, 1e-5, "expect first tangent on right looking towards centre")
+	assert.InDelta(t, -2.4, tangents[1].End.Y, 1e-5)
+	for _, tangent := range tangents {
+		assert.Equal(t, point.Point{X: 5, Y: 0}, tangent.Start)
+		assert.InDelta(t, 4, tangent.Length(), 1e-5)
+		assertTangent(t, c, tangent, tangent.End, "tangent from point")
+	}
+
+	assert.Empty(t, c.TangentsFromPoint(point.Point{X: 1, Y: 1}), "expect no tangents from inside")
+	assert.Empty(t, c.TangentsFromPoint(point.Point{X: 0, Y: 3}), "expect no tangents from circumference")
+}
+
+// TestCommonTangents - test that CommonTangents finds the right number of tangents for each relation
+func TestCommonTangents(t *testing.T) {
+	c := NewCircle(0, 0, 2)
+	tests := []struct {
+		name  string
+		d     Circle
+		count int
+	}{
+		{"separate", NewCircle(10, 0, 3), 4},
+		{"touching", NewCircle(5, 0, 3), 3},
+		{"crossing", NewCircle(3, 0, 3), 2},
+		{"touching inside", NewCircle(1, 0, 3), 1},
+		{"inside", NewCircle(0.5, 0, 3), 0},
+		{"coincident", NewCircle(0, 0, 2), 0},
+	}
+	for _, test := range tests {
+		tangents := c.CommonTangents(test.d)
+		assert.Len(t, tangents, test.count, test.name)
+		for _, tangent := range tangents {
+			assertTangent(t, c, tangent, tangent.Start, test.name)
+			assertTangent(t, test.d, tangent, tangent.End, test.name)
+		}
+	}
+
+	// equal circles have external tangents parallel to the line of centres
+	tangents := c.CommonTangents(NewCircle(10, 0, 2))
+	assert.Equal(t, line.LineSegment{Start: point.Point{X: 0, Y: 2}, End: point.Point{X: 10, Y: 2}}, tangents[0])
+	assert.Equal(t, line.LineSegment{Start: point.Point{X: 0, Y: -2}, End: point.Point{X: 10, Y: -2}}, tangents[1])
+	// internal tangents cross the line of centres half way
+	for _, tangent := range tangents[2:] {
+		_, ok := tangent.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: 4.9, Y: 0}, End: point.Point{X: 5.1, Y: 0}})
+		assert.True(t, ok, "expect internal tangent to pass between circles")
+	}
+}
+
+// TestNewCircumcircle - test that NewCircumcircle behaves as expected
+func TestNewCircumcircle(t *testing.T) {
+	circumcircle, err := NewCircumcircle(point.Point{X: 0, Y: 0}, point.Point{X: 6, Y: 0}, point.Point{X: 0, Y: 8})
+	assert.Nil(t, err)
+	assert.Equal(t, point.Point{X: 3, Y: 4}, circumcircle.centre, "expect centre at middle of hypotenuse")
+	assert.Equal(t, float32(5), circumcircle.radius)
+
+	_, err = NewCircumcircle(point.Point{X: 0, Y: 0}, point.Point{X: 1, Y: 1}, point.Point{X: 3, Y: 3})
+	assert.ErrorIs(t, err, ErrCollinear)
+}
+
+// TestNewIncircle - test that NewIncircle behaves as expected
+func TestNewIncircle(t *testing.T) {
+	incircle, err := NewIncircle(point.Point{X: 0, Y: 0}, point.Point{X: 6, Y: 0}, point.Point{X: 0, Y: 8})
+	assert.Nil(t, err)
+	// radius of incircle of right triangle is (a + b - c) / 2
+	assert.InDelta(t, 2, incircle.radius, 1e-6)
+	assert.InDelta(t, 2, incircle.centre.X, 1e-6)
+	assert.InDelta(t, 2, incircle.centre.Y, 1e-6)
+
+	_, err = NewIncircle(point.Point{X: 0, Y: 0}, point.Point{X: 1, Y: 0}, point.Point{X: 2, Y: 0})
+	assert.ErrorIs(t, err, ErrCollinear)
+}
+
+// TestRadicalAxis - test that RadicalAxis behaves as expected
+func TestRadicalAxis(t *testing.T) {
+	c, d := NewCircle(0, 0, 5), NewCircle(8, 0, 5)
+	axis, err := c.RadicalAxis(d)
+	assert.Nil(t, err)
+	assert.Equal(t, line.LineSegment{Start: point.Point{X: 4, Y: 0}, End: point.Point{X: 4, Y: 1}}, axis)
+
+	// axis passes through intersection points of crossing circles
+	d = NewCircle(6, 3, 4)
+	axis, err = c.RadicalAxis(d)
+	assert.Nil(t, err)
+	points, _ := c.CircleIntersectionPoints(d)
+	for _, p := range points {
+		startX, startY := float64(p.X-axis.Start.X), float64(p.Y-axis.Start.Y)
+		directionX, directionY := float64(axis.End.X-axis.Start.X), float64(axis.End.Y-axis.Start.Y)
+		assert.InDelta(t, 0, startX*directionY-startY*directionX, 1e-5, "expect intersection point on axis")
+	}
+	assert.InDelta(t, 1, math.Hypot(float64(axis.End.X-axis.Start.X), float64(axis.End.Y-axis.Start.Y)), 1e-6)
+
+	_, err = c.RadicalAxis(NewCircle(0, 0, 2))
+	assert.ErrorIs(t, err, ErrConcentric)
+}
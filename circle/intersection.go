@@ -0,0 +1,140 @@
+package circle
+
+import (
+	"collision/line"
+	"collision/point"
+	"math"
+)
+
+// CircleRelation - how two circles lie relative to each other
+type CircleRelation int
+
+const (
+	CirclesSeparate   CircleRelation = iota // circles share no points and neither is inside the other
+	CirclesTouching                         // circles touch from outside at a single point
+	CirclesCrossing                         // circumferences cross at two points
+	CircleInside                            // circle lies inside the other, touching it at one point at most
+	CircleEncloses                          // other circle lies inside this one, touching it at one point at most
+	CirclesCoincident                       // circles are the same circle
+)
+
+// String - name of relation
+func (r CircleRelation) String() string {
+	switch r {
+	case CirclesTouching:
+		return "touching"
+	case CirclesCrossing:
+		return "crossing"
+	case CircleInside:
+		return "inside"
+	case CircleEncloses:
+		return "encloses"
+	case CirclesCoincident:
+		return "coincident"
+	}
+	return "separate"
+}
+
+// CircleIntersectionPoints - returns the points at which the circumferences of two circles meet and how
+// the circles lie relative to each other. Crossing circles meet at two points, the first lying to the left
+// when looking from the centre of c to the centre of d. Tangent circles meet at one point, which is also
+// returned when one circle touches the inside of the other. Tangency is decided using global delta.
+func (c Circle) CircleIntersectionPoints(d Circle) ([]point.Point, CircleRelation) {
+	return c.CircleIntersectionPointsWithTolerance(d, point.DefaultTolerance)
+}
+
+// CircleIntersectionPointsWithTolerance - as CircleIntersectionPoints, deciding tangency and coincidence
+// under the tolerance
+func (c Circle) CircleIntersectionPointsWithTolerance(d Circle, tol point.Tolerance) ([]point.Point, CircleRelation) {
+	cx, cy, r0 := float64(c.centre.X), float64(c.centre.Y), float64(c.radius)
+	dx, dy, r1 := float64(d.centre.X)-cx, float64(d.centre.Y)-cy, float64(d.radius)
+	distance := math.Hypot(dx, dy)
+
+	// relation of the smaller circle to the larger, when one is inside the other
+	inside := CircleInside
+	if r0 > r1 {
+		inside = CircleEncloses
+	}
+
+	switch {
+	case c.centre.AreTouchingWithTolerance(d.centre, tol) && tol.AreWithin(c.radius, d.radius):
+		return nil, CirclesCoincident
+	case tol.AreWithin(float32(distance), float32(r0+r1)):
+		// touching from outside, at the point radius of c along the line of centres
+		return []point.Point{{X: float32(cx + dx*r0/distance), Y: float32(cy + dy*r0/distance)}}, CirclesTouching
+	case distance > r0+r1:
+		return nil, CirclesSeparate
+	case distance > 0 && tol.AreWithin(float32(distance), float32(math.Abs(r0-r1))):
+		// touching inside, at the point of the larger circle in the direction of the centre of the smaller
+		bx, by, big, towards := cx, cy, r0, 1.0
+		if r0 < r1 {
+			bx, by, big, towards = cx+dx, cy+dy, r1, -1.0
+		}
+		scale := towards * big / distance
+		return []point.Point{{X: float32(bx + dx*scale), Y: float32(by + dy*scale)}}, inside
+	case distance < math.Abs(r0-r1):
+		return nil, inside
+	}
+
+	// crossing, at the two points either side of the line of centres
+	along := (distance*distance + r0*r0 - r1*r1) / (2 * distance)
+	across := math.Sqrt(math.Max(0, r0*r0-along*along))
+	mx, my := cx+dx*along/distance, cy+dy*along/distance
+	ux, uy := -dy/distance, dx/distance
+	return []point.Point{
+		{X: float32(mx + ux*across), Y: float32(my + uy*across)},
+		{X: float32(mx - ux*across), Y: float32(my - uy*across)},
+	}, CirclesCrossing
+}
+
+// LineSegmentIntersectionPoints - returns the points at which a line segment meets the circumference of a
+// circle, in order along the segment from its start. A segment crossing the circle meets it twice, a segment
+// with one end inside meets it once and a tangent segment touches it once. A segment lying wholly inside the
+// circle does not meet the circumference, though InstersectsLineSegment reports it as intersecting.
+// Tangency is decided using global delta.
+func (c Circle) LineSegmentIntersectionPoints(ls line.LineSegment) []point.Point {
+	return c.LineSegmentIntersectionPointsWithTolerance(ls, point.DefaultTolerance)
+}
+
+// LineSegmentIntersectionPointsWithTolerance - as LineSegmentIntersectionPoints, deciding tangency under the
+// tolerance
+func (c Circle) LineSegmentIntersectionPointsWithTolerance(ls line.LineSegment, tol point.Tolerance) []point.Point {
+	sx, sy := float64(ls.Start.X)-float64(c.centre.X), float64(ls.Start.Y)-float64(c.centre.Y)
+	dx, dy := float64(ls.End.X)-float64(ls.Start.X), float64(ls.End.Y)-float64(ls.Start.Y)
+	radius := float64(c.radius)
+	at := func(t float64) point.Point {
+		return point.Point{X: float32(float64(ls.Start.X) + t*dx), Y: float32(float64(ls.Start.Y) + t*dy)}
+	}
+
+	lengthSquared := dx*dx + dy*dy
+	if lengthSquared == 0 {
+		if c.CircumferenceTouchesPointWithTolerance(ls.Start, tol) {
+			return []point.Point{ls.Start}
+		}
+		return nil
+	}
+
+	// closest approach of the line to the centre, as a parameter along the segment
+	closest := -(sx*dx + sy*dy) / lengthSquared
+	missDistance := math.Abs(sx*dy-sy*dx) / math.Sqrt(lengthSquared)
+	if tol.AreWithin(float32(missDistance), c.radius) {
+		// tangent line touches at its closest approach
+		if closest < 0 || closest > 1 {
+			return nil
+		}
+		return []point.Point{at(closest)}
+	}
+	if missDistance > radius {
+		return nil
+	}
+
+	// line crosses circumference either side of its closest approach
+	halfChord := math.Sqrt(radius*radius-missDistance*missDistance) / math.Sqrt(lengthSquared)
+	var points []point.Point
+	for _, t := range []float64{closest - halfChord, closest + halfChord} {
+		if t >= 0 && t <= 1 {
+			points = append(points, at(t))
+		}
+	}
+	return points
+}
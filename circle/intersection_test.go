@@ -0,0 +1,84 @@
+package circle
+
+import (
+	"collision/line"
+	"collision/point"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCircleIntersectionPoints - test that CircleIntersectionPoints reports points and relation as expected
+func TestCircleIntersectionPoints(t *testing.T) {
+	c := NewCircle(0, 0, 5)
+
+	points, relation := c.CircleIntersectionPoints(NewCircle(8, 0, 5))
+	assert.Equal(t, CirclesCrossing, relation)
+	assert.Equal(t, []point.Point{{X: 4, Y: 3}, {X: 4, Y: -3}}, points, "expect left point first")
+
+	points, relation = c.CircleIntersectionPoints(NewCircle(0, 8, 3))
+	assert.Equal(t, CirclesTouching, relation)
+	assert.Equal(t, []point.Point{{X: 0, Y: 5}}, points)
+
+	points, relation = c.CircleIntersectionPoints(NewCircle(20, 0, 3))
+	assert.Equal(t, CirclesSeparate, relation)
+	assert.Empty(t, points)
+
+	// containment, strictly and touching inside
+	points, relation = c.CircleIntersectionPoints(NewCircle(1, 0, 2))
+	assert.Equal(t, CircleEncloses, relation)
+	assert.Empty(t, points)
+	points, relation = NewCircle(1, 0, 2).CircleIntersectionPoints(c)
+	assert.Equal(t, CircleInside, relation)
+	assert.Equal(t, "inside", relation.String())
+	assert.Empty(t, points)
+	points, relation = c.CircleIntersectionPoints(NewCircle(-3, 0, 2))
+	assert.Equal(t, CircleEncloses, relation)
+	assert.Equal(t, []point.Point{{X: -5, Y: 0}}, points)
+	points, relation = NewCircle(-3, 0, 2).CircleIntersectionPoints(c)
+	assert.Equal(t, CircleInside, relation)
+	assert.Equal(t, []point.Point{{X: -5, Y: 0}}, points)
+
+	// concentric
+	_, relation = c.CircleIntersectionPoints(NewCircle(0, 0, 1))
+	assert.Equal(t, CircleEncloses, relation)
+	_, relation = c.CircleIntersectionPoints(NewCircle(0, 0, 5))
+	assert.Equal(t, CirclesCoincident, relation)
+
+	// nearly tangent circles are tangent under a coarse tolerance
+	_, relation = c.CircleIntersectionPoints(NewCircle(10.01, 0, 5))
+	assert.Equal(t, CirclesSeparate, relation)
+	points, relation = c.CircleIntersectionPointsWithTolerance(NewCircle(10.01, 0, 5), point.NewTolerance(0.1, 0))
+	assert.Equal(t, CirclesTouching, relation)
+	assert.Len(t, points, 1)
+}
+
+// TestLineSegmentIntersectionPoints - test that LineSegmentIntersectionPoints behaves as expected
+func TestLineSegmentIntersectionPoints(t *testing.T) {
+	c := NewCircle(0, 0, 5)
+
+	// crossing twice, in order along segment
+	ls := line.LineSegment{Start: point.Point{X: 10, Y: 3}, End: point.Point{X: -10, Y: 3}}
+	assert.Equal(t, []point.Point{{X: 4, Y: 3}, {X: -4, Y: 3}}, c.LineSegmentIntersectionPoints(ls))
+
+	// one end inside
+	ls = line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 0, Y: -10}}
+	assert.Equal(t, []point.Point{{X: 0, Y: -5}}, c.LineSegmentIntersectionPoints(ls))
+
+	// tangent
+	ls = line.LineSegment{Start: point.Point{X: -10, Y: 5}, End: point.Point{X: 10, Y: 5}}
+	assert.Equal(t, []point.Point{{X: 0, Y: 5}}, c.LineSegmentIntersectionPoints(ls))
+
+	// wholly inside, so intersecting without meeting circumference
+	ls = line.LineSegment{Start: point.Point{X: -1, Y: 0}, End: point.Point{X: 1, Y: 0}}
+	assert.Empty(t, c.LineSegmentIntersectionPoints(ls))
+	assert.True(t, c.InstersectsLineSegment(ls))
+
+	// line crosses circle, but segment stops short
+	ls = line.LineSegment{Start: point.Point{X: 6, Y: 0}, End: point.Point{X: 10, Y: 0}}
+	assert.Empty(t, c.LineSegmentIntersectionPoints(ls))
+
+	// zero length segment on circumference
+	ls = line.LineSegment{Start: point.Point{X: 3, Y: 4}, End: point.Point{X: 3, Y: 4}}
+	assert.Equal(t, []point.Point{{X: 3, Y: 4}}, c.LineSegmentIntersectionPoints(ls))
+}
@@ -0,0 +1,132 @@
+package circle
+
+import (
+	"collision/internal/vec"
+	"collision/line"
+	"collision/point"
+	"math"
+)
+
+// AnnularSector - solid region between two concentric arcs, such as a shockwave ring. A sweep of a full turn
+// gives an annulus, and an inner radius of zero gives a Sector.
+type AnnularSector struct {
+	centre point.Point // point at centre of arcs
+	inner  float32     // radius of inner arc
+	outer  float32     // radius of outer arc
+	start  float64     // angle of start of arcs, in range 0 to FullTurn
+	sweep  float64     // counterclockwise angle from start to end of arcs, greater than 0 and at most FullTurn
+}
+
+// Sector - solid region between two radii of a circle and the arc joining them, such as a firing cone
+type Sector struct {
+	AnnularSector
+}
+
+// NewAnnularSector - returns a new annular sector running counterclockwise from startAngle to endAngle, in
+// radians. Negative radii are replaced by their abs values and the radii are swapped if inner is greater than
+// outer. An end angle equal to the start angle, or a whole number of turns from it, gives a full annulus.
+func NewAnnularSector(centre point.Point, innerRadius, outerRadius, startAngle, endAngle float32) AnnularSector {
+	inner, outer := point.Abs(innerRadius), point.Abs(outerRadius)
+	if inner > outer {
+		inner, outer = outer, inner
+	}
+	start, sweep := normaliseAngles(startAngle, endAngle)
+	return AnnularSector{centre: centre, inner: inner, outer: outer, start: start, sweep: sweep}
+}
+
+// NewSector - returns a new sector of a circle running counterclockwise from startAngle to endAngle, in
+// radians. If radius is provided as a negative, then abs value is assigned. An end angle equal to the start
+// angle, or a whole number of turns from it, gives a full disk.
+func NewSector(centre point.Point, radius, startAngle, endAngle float32) Sector {
+	return Sector{NewAnnularSector(centre, 0, radius, startAngle, endAngle)}
+}
+
+// GetCentreAndRadii - returns a point indicating centre and float32s indicating inner and outer radii
+func (s AnnularSector) GetCentreAndRadii() (centre point.Point, innerRadius, outerRadius float32) {
+	return s.centre, s.inner, s.outer
+}
+
+// GetAngles - returns start and end angles, with the start in the range 0 to FullTurn and the end greater than
+// the start by at most FullTurn
+func (s AnnularSector) GetAngles() (startAngle, endAngle float32) {
+	return float32(s.start), float32(s.start + s.sweep)
+}
+
+// ContainsPoint - returns boolean indicating whether a point is inside or on the boundary of the region
+func (s AnnularSector) ContainsPoint(p point.Point) bool {
+	fromCentre := vec.Distance(s.centre, p)
+	if fromCentre < float64(s.inner) || fromCentre > float64(s.outer) {
+		return false
+	}
+	// centre of a sector lies on both of its radial edges
+	return fromCentre == 0 || angleWithin(s.start, s.sweep, angleOf(s.centre, p))
+}
+
+// IntersectsLineSegment - returns boolean indicating whether any point of a line segment lies in the region
+func (s AnnularSector) IntersectsLineSegment(ls line.LineSegment) bool {
+	if s.ContainsPoint(ls.Start) || s.ContainsPoint(ls.End) {
+		return true
+	}
+	// otherwise the segment must cross the boundary
+	for _, arc := range s.arcs() {
+		if len(arc.LineSegmentIntersectionPoints(ls)) > 0 {
+			return true
+		}
+	}
+	for _, edge := range s.radialEdges() {
+		if _, ok := edge.IntersectsLineSegmentRobust(ls); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IntersectsCircle - returns boolean indicating whether any point of the region lies in the circle
+func (s AnnularSector) IntersectsCircle(c Circle) bool {
+	return s.distanceToPoint(c.centre) <= float64(c.radius)
+}
+
+// DistanceToPoint - returns distance from point to nearest point of the region, zero if it is contained
+func (s AnnularSector) DistanceToPoint(p point.Point) float32 {
+	return float32(s.distanceToPoint(p))
+}
+
+// distanceToPoint - distance from point to nearest point of the region, in double precision
+func (s AnnularSector) distanceToPoint(p point.Point) float64 {
+	if s.ContainsPoint(p) {
+		return 0
+	}
+	// nearest point of region to a point outside it lies on the boundary
+	nearest := math.Inf(1)
+	for _, arc := range s.arcs() {
+		nearest = math.Min(nearest, arc.distanceToPoint(p))
+	}
+	for _, edge := range s.radialEdges() {
+		nearest = math.Min(nearest, float64(edge.DistanceToPoint(p)))
+	}
+	return nearest
+}
+
+// arcs - outer arc of boundary, and inner arc if inner radius is not zero
+func (s AnnularSector) arcs() []Arc {
+	arcs := []Arc{{centre: s.centre, radius: s.outer, start: s.start, sweep: s.sweep}}
+	if s.inner > 0 {
+		arcs = append(arcs, Arc{centre: s.centre, radius: s.inner, start: s.start, sweep: s.sweep})
+	}
+	return arcs
+}
+
+// radialEdges - straight edges of boundary at start and end angles, none for a full annulus
+func (s AnnularSector) radialEdges() []line.LineSegment {
+	if s.sweep >= FullTurn {
+		return nil
+	}
+	edges := make([]line.LineSegment, 0, 2)
+	for _, angle := range []float64{s.start, s.start + s.sweep} {
+		edges = append(edges, line.LineSegment{
+			Start: pointAtAngle(s.centre, float64(s.inner), angle),
+			End:   pointAtAngle(s.centre, float64(s.outer), angle),
+		})
+	}
+	return edges
+}
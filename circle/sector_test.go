@@ -0,0 +1,93 @@
+package circle
+
+import (
+	"collision/line"
+	"collision/point"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewAnnularSector - test that NewAnnularSector orders radii as expected
+func TestNewAnnularSector(t *testing.T) {
+	s := NewAnnularSector(point.Point{X: 1, Y: 1}, 5, -2, 0, math.Pi)
+	centre, inner, outer := s.GetCentreAndRadii()
+	assert.Equal(t, point.Point{X: 1, Y: 1}, centre)
+	assert.Equal(t, float32(2), inner)
+	assert.Equal(t, float32(5), outer)
+	start, end := s.GetAngles()
+	assert.InDelta(t, 0, start, 1e-6)
+	assert.InDelta(t, math.Pi, end, 1e-6)
+
+	_, inner, outer = NewSector(point.Point{}, 3, 0, 1).GetCentreAndRadii()
+	assert.Equal(t, float32(0), inner, "expect sector to have no inner radius")
+	assert.Equal(t, float32(3), outer)
+}
+
+// TestSectorFullTurn - test that sectors and annular sectors from 0 to 2π cover every angle
+func TestSectorFullTurn(t *testing.T) {
+	disk := NewSector(point.Point{}, 2, 0, 2*math.Pi)
+	start, end := disk.GetAngles()
+	assert.InDelta(t, FullTurn, end-start, 1e-6)
+	assert.True(t, disk.ContainsPoint(point.Point{X: 1, Y: -0.0000001}))
+
+	ring := NewAnnularSector(point.Point{}, 1, 2, math.Pi, 3*math.Pi)
+	start, end = ring.GetAngles()
+	assert.InDelta(t, FullTurn, end-start, 1e-6)
+	assert.True(t, ring.ContainsPoint(point.Point{X: -1.5, Y: 0.0000001}))
+	assert.True(t, ring.ContainsPoint(point.Point{X: 1.5, Y: 0}))
+}
+
+// TestSectorContainsPoint - test point containment of sectors and annular sectors
+func TestSectorContainsPoint(t *testing.T) {
+	// firing cone of 90 degrees facing along x axis
+	cone := NewSector(point.Point{}, 10, -math.Pi/4, math.Pi/4)
+	assert.True(t, cone.ContainsPoint(point.Point{}), "expect apex")
+	assert.True(t, cone.ContainsPoint(point.Point{X: 5, Y: 1}))
+	assert.True(t, cone.ContainsPoint(point.Point{X: 5, Y: 5}), "expect point on edge of cone")
+	assert.False(t, cone.ContainsPoint(point.Point{X: 5, Y: 6}), "expect point outside cone")
+	assert.False(t, cone.ContainsPoint(point.Point{X: 11, Y: 0}), "expect point out of range")
+	assert.False(t, cone.ContainsPoint(point.Point{X: -1, Y: 0}), "expect point behind")
+
+	// shockwave ring
+	ring := NewAnnularSector(point.Point{}, 4, 5, 0, 0)
+	assert.True(t, ring.ContainsPoint(point.Point{X: 0, Y: -4.5}))
+	assert.True(t, ring.ContainsPoint(point.Point{X: 3, Y: 4}), "expect point on outer edge")
+	assert.False(t, ring.ContainsPoint(point.Point{X: 0, Y: 0}), "expect centre of ring to be excluded")
+	assert.False(t, ring.ContainsPoint(point.Point{X: 2, Y: 2}))
+}
+
+// TestSectorIntersectsLineSegment - test segment intersection of sectors and annular sectors
+func TestSectorIntersectsLineSegment(t *testing.T) {
+	cone := NewSector(point.Point{}, 10, -math.Pi/4, math.Pi/4)
+	// crosses cone without either end inside
+	assert.True(t, cone.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: 5, Y: -8}, End: point.Point{X: 5, Y: 8}}))
+	// crosses only a radial edge
+	assert.True(t, cone.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: 2, Y: 3}, End: point.Point{X: 3, Y: 1}}))
+	// passes behind apex
+	assert.False(t, cone.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: -1, Y: -8}, End: point.Point{X: -1, Y: 8}}))
+	// cuts across arc only
+	assert.True(t, cone.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: 9.9, Y: -3}, End: point.Point{X: 9.9, Y: 3}}))
+
+	ring := NewAnnularSector(point.Point{}, 4, 5, 0, 0)
+	assert.True(t, ring.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 10, Y: 0}}), "expect segment crossing ring")
+	assert.False(t, ring.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: -1, Y: 0}, End: point.Point{X: 1, Y: 0}}), "expect segment in hole")
+	// chord of outer circle passing through hole crosses ring twice without ends inside
+	assert.True(t, ring.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: -6, Y: 1}, End: point.Point{X: 6, Y: 1}}))
+}
+
+// TestSectorIntersectsCircle - test circle overlap of sectors and annular sectors
+func TestSectorIntersectsCircle(t *testing.T) {
+	cone := NewSector(point.Point{}, 10, -math.Pi/4, math.Pi/4)
+	assert.True(t, cone.IntersectsCircle(NewCircle(5, 0, 1)), "expect circle inside cone")
+	assert.True(t, cone.IntersectsCircle(NewCircle(0, 6, 4.3)), "expect circle overlapping edge of cone")
+	assert.False(t, cone.IntersectsCircle(NewCircle(0, 6, 4)), "expect circle beside cone")
+	assert.False(t, cone.IntersectsCircle(NewCircle(12, 0, 1)), "expect circle out of range")
+	assert.InDelta(t, 1, cone.DistanceToPoint(point.Point{X: -1, Y: 0}), 1e-6, "expect distance to apex")
+
+	ring := NewAnnularSector(point.Point{}, 4, 5, 0, 0)
+	assert.False(t, ring.IntersectsCircle(NewCircle(0, 0, 3.5)), "expect circle in hole")
+	assert.True(t, ring.IntersectsCircle(NewCircle(0, 0, 4)), "expect circle touching inner edge")
+	assert.True(t, ring.IntersectsCircle(NewCircle(7, 0, 2.5)), "expect circle overlapping outer edge")
+}
@@ -0,0 +1,41 @@
+// Command replay re-executes a log written by a replay.Recorder and reports every call whose result differs from
+// the one recorded.
+//
+// Usage:
+//
+//	replay LOG
+//
+// Exits with status 1 if any result differs, and 2 if the log cannot be read.
+package main
+
+import (
+	"collision/replay"
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: replay LOG")
+		os.Exit(2)
+	}
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	defer f.Close()
+
+	calls, mismatches, err := replay.Replay(f, nil)
+	for _, m := range mismatches {
+		fmt.Println(m)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "after %d calls: %v\n", calls, err)
+		os.Exit(2)
+	}
+	fmt.Printf("%d calls replayed, %d differ\n", calls, len(mismatches))
+	if len(mismatches) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,126 @@
+// Package fixed provides a deterministic Q16.16 fixed-point numeric backend for collision tests.
+// All arithmetic is performed on integers, so results are bit-identical across compilers and
+// architectures. This is intended for lockstep simulation, where every client must reach the same
+// collision outcome.
+//
+// Coordinates must lie strictly within ±MaxCoordinate. Within that range every intermediate value used by the
+// predicates in this package (squared distances and cross products) fits in an int64 without overflow.
+// Conversions clamp values outside the range to its ends, and their checked forms reject them.
+package fixed
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// Fixed - a Q16.16 fixed-point number: 16 integer bits and 16 fractional bits
+type Fixed int32
+
+// FractionalBits - number of fractional bits in a Fixed
+const FractionalBits = 16
+
+// One - the Fixed representation of 1
+const One Fixed = 1 << FractionalBits
+
+// MaxCoordinate - largest coordinate magnitude for which predicates in this package are guaranteed not to overflow
+const MaxCoordinate = 1 << 14
+
+// MaxFixed - largest Fixed strictly within ±MaxCoordinate, which conversions clamp to
+const MaxFixed Fixed = MaxCoordinate*One - 1
+
+// ErrOutOfRange - value is not a finite number strictly within ±MaxCoordinate
+var ErrOutOfRange = errors.New("value out of fixed-point coordinate range")
+
+// FromInt - returns the Fixed representation of an integer, clamped to ±MaxFixed
+func FromInt(n int) Fixed {
+	switch {
+	case n >= MaxCoordinate:
+		return MaxFixed
+	case n <= -MaxCoordinate:
+		return -MaxFixed
+	}
+	return Fixed(n << FractionalBits)
+}
+
+// FromIntChecked - returns the Fixed representation of an integer. Returns ErrOutOfRange if n is not strictly
+// within ±MaxCoordinate.
+func FromIntChecked(n int) (Fixed, error) {
+	if n >= MaxCoordinate || n <= -MaxCoordinate {
+		return 0, ErrOutOfRange
+	}
+	return FromInt(n), nil
+}
+
+// FromFloat32 - returns the Fixed nearest to a float32, clamped to ±MaxFixed, with NaN giving zero. Conversion
+// is the only step that touches floats, so it should happen once, when data enters the simulation.
+func FromFloat32(f float32) Fixed {
+	// clamp before converting, since converting a float out of range of int32 gives a value that depends on the
+	// architecture
+	scaled := math.Round(float64(f) * float64(One))
+	switch {
+	case math.IsNaN(scaled):
+		return 0
+	case scaled > float64(MaxFixed):
+		return MaxFixed
+	case scaled < -float64(MaxFixed):
+		return -MaxFixed
+	}
+	return Fixed(scaled)
+}
+
+// FromFloat32Checked - returns the Fixed nearest to a float32. Returns ErrOutOfRange if f is NaN, infinite or
+// not strictly within ±MaxCoordinate.
+func FromFloat32Checked(f float32) (Fixed, error) {
+	if math.IsNaN(float64(f)) || math.Abs(float64(f)) >= MaxCoordinate {
+		return 0, ErrOutOfRange
+	}
+	return FromFloat32(f), nil
+}
+
+// Float32 - returns the float32 value of a Fixed, for rendering or debugging
+func (f Fixed) Float32() float32 {
+	return float32(f) / float32(One)
+}
+
+// Abs - absolute value of a Fixed
+func (f Fixed) Abs() Fixed {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// Mul - product of two Fixed values, truncated towards negative infinity
+func (f Fixed) Mul(g Fixed) Fixed {
+	return Fixed((int64(f) * int64(g)) >> FractionalBits)
+}
+
+// Div - quotient of two Fixed values, truncated towards zero. Panics if g is zero.
+func (f Fixed) Div(g Fixed) Fixed {
+	return Fixed((int64(f) << FractionalBits) / int64(g))
+}
+
+// Sqrt - square root of a Fixed, rounded down. Negative values return zero.
+func (f Fixed) Sqrt() Fixed {
+	if f <= 0 {
+		return 0
+	}
+	return Fixed(Isqrt(uint64(f) << FractionalBits))
+}
+
+// Isqrt - integer square root of n, rounded down, computed without floating point
+func Isqrt(n uint64) uint64 {
+	if n < 2 {
+		return n
+	}
+	// initial estimate is a power of two no smaller than the root
+	x := uint64(1) << ((bits.Len64(n) + 1) / 2)
+	for {
+		y := (x + n/x) / 2
+		if y >= x {
+			return x
+		}
+		x = y
+	}
+}
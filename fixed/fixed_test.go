@@ -0,0 +1,123 @@
+package fixed
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestArithmetic - test that Fixed arithmetic behaves as expected
+func TestArithmetic(t *testing.T) {
+	assert.Equal(t, FromInt(3), FromFloat32(3), "integer and float conversion should agree")
+	assert.Equal(t, float32(1.5), FromFloat32(1.5).Float32(), "1.5 should be represented exactly")
+	assert.Equal(t, FromFloat32(3.75), FromFloat32(1.5).Mul(FromFloat32(2.5)), "1.5 * 2.5 should be 3.75")
+	assert.Equal(t, FromInt(3), FromFloat32(7.5).Div(FromFloat32(2.5)), "7.5 / 2.5 should be 3")
+	assert.Equal(t, FromFloat32(0.6)-1, FromFloat32(1.5).Div(FromFloat32(2.5)), "1.5 / 2.5 should truncate below 0.6")
+	assert.Equal(t, FromInt(3), FromInt(9).Sqrt(), "square root of 9 should be 3")
+	assert.Equal(t, FromFloat32(1.5), FromFloat32(2.25).Sqrt(), "square root of 2.25 should be 1.5")
+	assert.Equal(t, Fixed(0), FromInt(-4).Sqrt(), "square root of negative should be 0")
+	assert.Equal(t, FromInt(4), FromInt(-4).Abs(), "abs of -4 should be 4")
+}
+
+// TestConversionRange - test that conversions clamp values outside ±MaxCoordinate to the same result on every
+// architecture, and that their checked forms reject them
+func TestConversionRange(t *testing.T) {
+	assert.Equal(t, MaxFixed, FromFloat32(1e6))
+	assert.Equal(t, -MaxFixed, FromFloat32(-1e6))
+	assert.Equal(t, Fixed(0), FromFloat32(float32(math.NaN())))
+	assert.Equal(t, MaxFixed, FromFloat32(float32(math.Inf(1))))
+	assert.Equal(t, -MaxFixed, FromFloat32(float32(math.Inf(-1))))
+	assert.Equal(t, MaxFixed, FromInt(1e6))
+	assert.Equal(t, -MaxFixed, FromInt(-1e6))
+	assert.Equal(t, MaxFixed, FromFloat32(MaxCoordinate), "expect range to exclude its ends")
+
+	for _, f := range []float32{1e6, -1e6, float32(math.NaN()), float32(math.Inf(1)), MaxCoordinate, -MaxCoordinate} {
+		_, err := FromFloat32Checked(f)
+		assert.ErrorIs(t, err, ErrOutOfRange, "%v", f)
+	}
+	for _, n := range []int{1e6, -1e6, MaxCoordinate} {
+		_, err := FromIntChecked(n)
+		assert.ErrorIs(t, err, ErrOutOfRange, "%v", n)
+	}
+	f, err := FromFloat32Checked(-1.5)
+	assert.Nil(t, err)
+	assert.Equal(t, -One-One/2, f)
+	f, err = FromIntChecked(MaxCoordinate - 1)
+	assert.Nil(t, err)
+	assert.Equal(t, Fixed((MaxCoordinate-1)*One), f)
+}
+
+// TestIsqrt - test that Isqrt rounds down for all values near perfect squares
+func TestIsqrt(t *testing.T) {
+	for n := uint64(1); n < 5000; n++ {
+		root := Isqrt(n * n)
+		assert.Equal(t, n, root, "root of perfect square should be exact")
+		root = Isqrt(n*n - 1)
+		assert.Equal(t, n-1, root, "root below perfect square should round down")
+	}
+	assert.Equal(t, uint64(0xFFFFFFFF), Isqrt(^uint64(0)), "root of max uint64 should be max uint32")
+}
+
+// TestDistance - test that Distance behaves as expected
+func TestDistance(t *testing.T) {
+	a := NewPoint(point.Point{X: 0, Y: 0})
+	b := NewPoint(point.Point{X: 3, Y: 4})
+	assert.Equal(t, FromInt(5), a.Distance(b), "distance should be 5")
+	assert.Equal(t, int64(FromInt(5))*int64(FromInt(5)), a.DistanceSquared(b), "distance squared should be 25")
+	assert.True(t, a.AreTouching(NewPoint(point.Point{X: 0, Y: 0})), "a should touch itself")
+
+	// opposite corners of the coordinate range are further apart than MaxFixed
+	low := Point{X: -MaxFixed, Y: -MaxFixed}
+	high := Point{X: MaxFixed, Y: MaxFixed}
+	assert.Equal(t, MaxFixed, low.Distance(high), "distance across range should clamp")
+	assert.Equal(t, MaxFixed, NewPoint(point.Point{X: -16000, Y: -16000}).Distance(NewPoint(point.Point{X: 16000, Y: 16000})), "distance across range should clamp")
+	assert.Equal(t, 8*int64(MaxFixed)*int64(MaxFixed), low.DistanceSquared(high), "distance squared across range should be exact")
+	assert.Equal(t, FromInt(16000), NewPoint(point.Point{X: -8000, Y: 0}).Distance(NewPoint(point.Point{X: 8000, Y: 0})), "distance within MaxFixed should be exact")
+}
+
+// TestSegment - test that segment predicates behave as expected
+func TestSegment(t *testing.T) {
+	s := NewSegment(line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 10, Y: 10}})
+	u := NewSegment(line.LineSegment{Start: point.Point{X: 0, Y: 10}, End: point.Point{X: 10, Y: 0}})
+	assert.True(t, s.IntersectsSegment(u), "segments should cross")
+	assert.True(t, s.HasPoint(NewPoint(point.Point{X: 5, Y: 5})), "point should be on segment")
+	assert.False(t, s.HasPoint(NewPoint(point.Point{X: 11, Y: 11})), "point should not be on segment")
+
+	u = NewSegment(line.LineSegment{Start: point.Point{X: 0, Y: 1}, End: point.Point{X: 10, Y: 11}})
+	assert.False(t, s.IntersectsSegment(u), "parallel segments should not meet")
+
+	u = NewSegment(line.LineSegment{Start: point.Point{X: 10, Y: 10}, End: point.Point{X: 20, Y: 20}})
+	assert.True(t, s.IntersectsSegment(u), "collinear segments should touch")
+
+	// extreme coordinates must not overflow
+	s = Segment{Start: Point{X: -MaxCoordinate*One + 1, Y: -MaxCoordinate*One + 1}, End: Point{X: MaxCoordinate*One - 1, Y: MaxCoordinate*One - 1}}
+	u = Segment{Start: Point{X: -MaxCoordinate*One + 1, Y: MaxCoordinate*One - 1}, End: Point{X: MaxCoordinate*One - 1, Y: -MaxCoordinate*One + 1}}
+	assert.True(t, s.IntersectsSegment(u), "segments spanning full range should cross")
+}
+
+// TestShapes - test that circle and rectangle predicates behave as expected
+func TestShapes(t *testing.T) {
+	c := NewCircle(circle.NewCircle(0, 0, 10))
+	d := NewCircle(circle.NewCircle(20, 0, 10))
+	assert.True(t, c.CirclesIntersect(d), "circles touching at one point should intersect")
+	d = NewCircle(circle.NewCircle(20.0001, 0, 10))
+	assert.False(t, c.CirclesIntersect(d), "circles should not intersect")
+
+	assert.True(t, c.ContainsPoint(NewPoint(point.Point{X: 6, Y: 8})), "point on circumference should be contained")
+	assert.True(t, c.IntersectsSegment(NewSegment(line.LineSegment{Start: point.Point{X: 10, Y: 50}, End: point.Point{X: 10, Y: -100}})), "tangent segment should intersect")
+	assert.False(t, c.IntersectsSegment(NewSegment(line.LineSegment{Start: point.Point{X: 10.001, Y: 50}, End: point.Point{X: 10.001, Y: -100}})), "segment should miss")
+
+	xy, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 10, Y: -5}, {X: 20, Y: 5}})
+	assert.Nil(t, err)
+	r := NewRectangle(xy)
+	assert.True(t, r.IntersectsCircle(c), "rectangle should touch circle")
+	assert.False(t, r.IntersectsCircle(NewCircle(circle.NewCircle(0, 0, 9.99))), "rectangle should miss circle")
+	assert.True(t, r.IntersectsSegment(NewSegment(line.LineSegment{Start: point.Point{X: 15, Y: -10}, End: point.Point{X: 15, Y: 10}})), "segment should cross rectangle")
+	assert.True(t, r.ContainsPoint(NewPoint(point.Point{X: 20, Y: 5})), "corner should be contained")
+	assert.True(t, r.IntersectsRectangle(Rectangle{Min: Point{X: FromInt(20), Y: FromInt(5)}, Max: Point{X: FromInt(30), Y: FromInt(30)}}), "rectangles should touch")
+}
@@ -0,0 +1,97 @@
+package fixed
+
+import (
+	"collision/point"
+	"math/bits"
+)
+
+// Point - represents a point in 2D space with fixed-point coordinates
+type Point struct {
+	X Fixed // position in x dimension
+	Y Fixed // position in y dimension
+}
+
+// NewPoint - returns the fixed-point Point nearest to a point.Point
+func NewPoint(p point.Point) Point {
+	return Point{X: FromFloat32(p.X), Y: FromFloat32(p.Y)}
+}
+
+// ToPoint - returns the float32 point.Point equivalent of a fixed-point Point
+func (A Point) ToPoint() point.Point {
+	return point.Point{X: A.X.Float32(), Y: A.Y.Float32()}
+}
+
+// AreTouching - determine if two points are the same point. Fixed-point comparison is exact, so no delta is required.
+func (A Point) AreTouching(B Point) bool {
+	return A == B
+}
+
+// DistanceSquared - squared distance between two points, in raw units of 2^-32
+func (A Point) DistanceSquared(B Point) int64 {
+	dx := int64(A.X) - int64(B.X)
+	dy := int64(A.Y) - int64(B.Y)
+	return dx*dx + dy*dy
+}
+
+// Distance - distance between two points, rounded down, computed with an integer square root. Points within the
+// coordinate range can be almost 2√2 MaxCoordinate apart, so distances beyond MaxFixed are clamped to it;
+// compare DistanceSquared where distances that large matter.
+func (A Point) Distance(B Point) Fixed {
+	distance := Isqrt(uint64(A.DistanceSquared(B)))
+	if distance > uint64(MaxFixed) {
+		return MaxFixed
+	}
+	return Fixed(distance)
+}
+
+// cross - cross product of vectors AB and AC in raw units of 2^-32. Positive if C lies to the left of AB.
+func cross(a, b, c Point) int64 {
+	abx, aby := int64(b.X)-int64(a.X), int64(b.Y)-int64(a.Y)
+	acx, acy := int64(c.X)-int64(a.X), int64(c.Y)-int64(a.Y)
+	return abx*acy - aby*acx
+}
+
+// dot - dot product of vectors AB and AC in raw units of 2^-32
+func dot(a, b, c Point) int64 {
+	abx, aby := int64(b.X)-int64(a.X), int64(b.Y)-int64(a.Y)
+	acx, acy := int64(c.X)-int64(a.X), int64(c.Y)-int64(a.Y)
+	return abx*acx + aby*acy
+}
+
+// sign - sign of an int64 as -1, 0 or 1
+func sign(n int64) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// productsCompare - compare a*b with c*d using 128 bit products, returning -1, 0 or 1
+func productsCompare(a, b, c, d uint64) int {
+	hi1, lo1 := bits.Mul64(a, b)
+	hi2, lo2 := bits.Mul64(c, d)
+	switch {
+	case hi1 < hi2 || (hi1 == hi2 && lo1 < lo2):
+		return -1
+	case hi1 > hi2 || (hi1 == hi2 && lo1 > lo2):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareInt64 - compare two int64 values, returning -1, 0 or 1
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,69 @@
+package fixed
+
+import (
+	"collision/line"
+)
+
+// Segment - line segment defined by fixed-point start and end points
+type Segment struct {
+	Start Point // start point
+	End   Point // end point
+}
+
+// NewSegment - returns the fixed-point Segment nearest to a line.LineSegment
+func NewSegment(ls line.LineSegment) Segment {
+	return Segment{Start: NewPoint(ls.Start), End: NewPoint(ls.End)}
+}
+
+// LengthSquared - squared length of segment, in raw units of 2^-32
+func (s Segment) LengthSquared() int64 {
+	return s.Start.DistanceSquared(s.End)
+}
+
+// HasPoint - returns boolean indicating whether point lies exactly on segment
+func (s Segment) HasPoint(p Point) bool {
+	if cross(s.Start, s.End, p) != 0 {
+		return false
+	}
+	return withinBounds(s.Start, s.End, p)
+}
+
+// IntersectsSegment - returns boolean indicating whether two segments share at least one point.
+// The decision uses exact integer orientation tests.
+func (s Segment) IntersectsSegment(t Segment) bool {
+	o1 := sign(cross(s.Start, s.End, t.Start))
+	o2 := sign(cross(s.Start, s.End, t.End))
+	o3 := sign(cross(t.Start, t.End, s.Start))
+	o4 := sign(cross(t.Start, t.End, s.End))
+	if o1*o2 < 0 && o3*o4 < 0 {
+		return true
+	}
+	return (o1 == 0 && withinBounds(s.Start, s.End, t.Start)) ||
+		(o2 == 0 && withinBounds(s.Start, s.End, t.End)) ||
+		(o3 == 0 && withinBounds(t.Start, t.End, s.Start)) ||
+		(o4 == 0 && withinBounds(t.Start, t.End, s.End))
+}
+
+// compareDistanceSquared - compare the squared distance from p to the segment with limit (both in raw
+// units of 2^-32), returning -1, 0 or 1. This avoids any division, so it is exact.
+func (s Segment) compareDistanceSquared(p Point, limit int64) int {
+	lengthSquared := s.LengthSquared()
+	projection := dot(s.Start, s.End, p)
+	switch {
+	case lengthSquared == 0 || projection <= 0:
+		return compareInt64(s.Start.DistanceSquared(p), limit)
+	case projection >= lengthSquared:
+		return compareInt64(s.End.DistanceSquared(p), limit)
+	}
+	// perpendicular distance squared is cross^2 / lengthSquared
+	c := cross(s.Start, s.End, p)
+	if c < 0 {
+		c = -c
+	}
+	return productsCompare(uint64(c), uint64(c), uint64(limit), uint64(lengthSquared))
+}
+
+// withinBounds - returns boolean indicating whether p lies in the bounding box of a and b
+func withinBounds(a, b, p Point) bool {
+	return min(a.X, b.X) <= p.X && p.X <= max(a.X, b.X) && min(a.Y, b.Y) <= p.Y && p.Y <= max(a.Y, b.Y)
+}
@@ -0,0 +1,97 @@
+package fixed
+
+import (
+	"collision/circle"
+	"collision/point"
+	"collision/polygon"
+)
+
+// Circle - defined by fixed-point centre and radius
+type Circle struct {
+	Centre Point // point at centre of circle
+	Radius Fixed // radius of circle
+}
+
+// NewCircle - returns the fixed-point Circle nearest to a circle.Circle
+func NewCircle(c circle.Circle) Circle {
+	centre, radius := c.GetCentreAndRadius()
+	return Circle{Centre: NewPoint(centre), Radius: FromFloat32(radius)}
+}
+
+// radiusSquared - squared radius in raw units of 2^-32
+func (c Circle) radiusSquared() int64 {
+	return int64(c.Radius) * int64(c.Radius)
+}
+
+// ContainsPoint - returns boolean indicating whether a point is inside or on a circle
+func (c Circle) ContainsPoint(p Point) bool {
+	return c.Centre.DistanceSquared(p) <= c.radiusSquared()
+}
+
+// CirclesIntersect - returns boolean indicating whether two circles intersect. Squared distances are compared,
+// so circles touching at one point are reported consistently.
+func (c Circle) CirclesIntersect(d Circle) bool {
+	sumOfRadii := int64(c.Radius) + int64(d.Radius)
+	return c.Centre.DistanceSquared(d.Centre) <= sumOfRadii*sumOfRadii
+}
+
+// IntersectsSegment - returns boolean indicating whether circle intersects segment
+func (c Circle) IntersectsSegment(s Segment) bool {
+	return s.compareDistanceSquared(c.Centre, c.radiusSquared()) <= 0
+}
+
+// Rectangle - rectangle with horizontal and vertical edges, defined by its minimum and maximum corners
+type Rectangle struct {
+	Min Point // corner with minimum x and y
+	Max Point // corner with maximum x and y
+}
+
+// NewRectangle - returns the fixed-point Rectangle nearest to an XYRectangle
+func NewRectangle(r *polygon.XYRectangle) Rectangle {
+	minX, maxX, minY, maxY, _ := point.GetMinMax(r.Vertices[:])
+	return Rectangle{
+		Min: Point{X: FromFloat32(minX), Y: FromFloat32(minY)},
+		Max: Point{X: FromFloat32(maxX), Y: FromFloat32(maxY)},
+	}
+}
+
+// ContainsPoint - boolean indicating whether a rectangle contains a point, including its edges
+func (r Rectangle) ContainsPoint(p Point) bool {
+	return r.Min.X <= p.X && p.X <= r.Max.X && r.Min.Y <= p.Y && p.Y <= r.Max.Y
+}
+
+// IntersectsRectangle - boolean indicating whether two rectangles overlap or touch
+func (r Rectangle) IntersectsRectangle(s Rectangle) bool {
+	return r.Min.X <= s.Max.X && s.Min.X <= r.Max.X && r.Min.Y <= s.Max.Y && s.Min.Y <= r.Max.Y
+}
+
+// IntersectsCircle - boolean indicating whether a rectangle and circle overlap or touch
+func (r Rectangle) IntersectsCircle(c Circle) bool {
+	closest := Point{
+		X: min(max(c.Centre.X, r.Min.X), r.Max.X),
+		Y: min(max(c.Centre.Y, r.Min.Y), r.Max.Y),
+	}
+	return c.ContainsPoint(closest)
+}
+
+// IntersectsSegment - boolean indicating whether a rectangle and segment overlap or touch
+func (r Rectangle) IntersectsSegment(s Segment) bool {
+	if r.ContainsPoint(s.Start) || r.ContainsPoint(s.End) {
+		return true
+	}
+	for _, edge := range r.Edges() {
+		if edge.IntersectsSegment(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Edges - returns the four edges of the rectangle, starting from the minimum corner in the same ABCD order as XYRectangle
+func (r Rectangle) Edges() [4]Segment {
+	a := r.Min
+	b := Point{X: r.Min.X, Y: r.Max.Y}
+	c := r.Max
+	d := Point{X: r.Max.X, Y: r.Min.Y}
+	return [4]Segment{{Start: a, End: b}, {Start: b, End: c}, {Start: c, End: d}, {Start: d, End: a}}
+}
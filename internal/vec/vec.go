@@ -0,0 +1,82 @@
+// Package vec provides the double precision vector used inside the module's packages while computing on float32
+// points, so that intermediate results are not rounded at every step.
+package vec
+
+import (
+	"collision/point"
+	"math"
+)
+
+// Vec - double precision vector
+type Vec struct {
+	X, Y float64
+}
+
+// FromPoint - point as a vector
+func FromPoint(p point.Point) Vec {
+	return Vec{float64(p.X), float64(p.Y)}
+}
+
+// Add - vector sum
+func (a Vec) Add(b Vec) Vec {
+	return Vec{a.X + b.X, a.Y + b.Y}
+}
+
+// Sub - vector difference
+func (a Vec) Sub(b Vec) Vec {
+	return Vec{a.X - b.X, a.Y - b.Y}
+}
+
+// Scale - vector multiplied by scalar
+func (a Vec) Scale(s float64) Vec {
+	return Vec{a.X * s, a.Y * s}
+}
+
+// Dot - dot product
+func (a Vec) Dot(b Vec) float64 {
+	return a.X*b.X + a.Y*b.Y
+}
+
+// Cross - z component of cross product, positive if b is counterclockwise of a
+func (a Vec) Cross(b Vec) float64 {
+	return a.X*b.Y - a.Y*b.X
+}
+
+// Length - magnitude of vector
+func (a Vec) Length() float64 {
+	return math.Hypot(a.X, a.Y)
+}
+
+// LengthSquared - square of magnitude of vector
+func (a Vec) LengthSquared() float64 {
+	return a.X*a.X + a.Y*a.Y
+}
+
+// Neg - vector in opposite direction
+func (a Vec) Neg() Vec {
+	return Vec{-a.X, -a.Y}
+}
+
+// Unit - vector of unit length in same direction, or the zero vector if it has no length
+func (a Vec) Unit() Vec {
+	length := a.Length()
+	if length == 0 {
+		return Vec{}
+	}
+	return a.Scale(1 / length)
+}
+
+// LeftNormal - vector rotated counterclockwise by a right angle
+func (a Vec) LeftNormal() Vec {
+	return Vec{-a.Y, a.X}
+}
+
+// Point - vector as a point, rounded to float32
+func (a Vec) Point() point.Point {
+	return point.Point{X: float32(a.X), Y: float32(a.Y)}
+}
+
+// Distance - distance between points in double precision
+func Distance(a, b point.Point) float64 {
+	return math.Hypot(float64(a.X)-float64(b.X), float64(a.Y)-float64(b.Y))
+}
@@ -0,0 +1,27 @@
+package vec
+
+import (
+	"collision/point"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVec - test that vector arithmetic behaves as expected
+func TestVec(t *testing.T) {
+	a, b := Vec{X: 3, Y: 4}, Vec{X: 1, Y: -2}
+	assert.Equal(t, Vec{X: 4, Y: 2}, a.Add(b))
+	assert.Equal(t, Vec{X: 2, Y: 6}, a.Sub(b))
+	assert.Equal(t, Vec{X: 6, Y: 8}, a.Scale(2))
+	assert.Equal(t, -5.0, a.Dot(b))
+	assert.Equal(t, -10.0, a.Cross(b), "expect b clockwise of a")
+	assert.Equal(t, 5.0, a.Length())
+	assert.Equal(t, 25.0, a.LengthSquared())
+	assert.Equal(t, Vec{X: -3, Y: -4}, a.Neg())
+	assert.InDelta(t, 0.6, a.Unit().X, 1e-15)
+	assert.InDelta(t, 0.8, a.Unit().Y, 1e-15)
+	assert.Equal(t, Vec{}, Vec{}.Unit(), "expect zero vector to have no direction")
+	assert.Equal(t, Vec{X: -4, Y: 3}, a.LeftNormal())
+	assert.Equal(t, point.Point{X: 1.5, Y: -2}, FromPoint(point.Point{X: 1.5, Y: -2}).Point())
+	assert.Equal(t, 5.0, Distance(point.Point{X: 1, Y: 1}, point.Point{X: 4, Y: 5}))
+}
@@ -0,0 +1,121 @@
+package line
+
+import (
+	"collision/point"
+	"collision/robust"
+	"math"
+)
+
+// IntersectionKind - how two line segments meet
+type IntersectionKind int
+
+const (
+	IntersectionNone    IntersectionKind = iota // segments do not meet
+	IntersectionPoint                           // segments meet at a single point
+	IntersectionOverlap                         // collinear segments share a stretch of non-zero length
+)
+
+// String - name of intersection kind
+func (k IntersectionKind) String() string {
+	switch k {
+	case IntersectionPoint:
+		return "point"
+	case IntersectionOverlap:
+		return "overlap"
+	}
+	return "none"
+}
+
+// Intersection - where two line segments meet. Parameters run from 0 at the start of a segment to 1 at its end.
+type Intersection struct {
+	Kind    IntersectionKind // how the segments meet
+	Overlap LineSegment      // shared part, in the direction of the first segment. Start and End are equal for a point.
+	T       [2]float32       // parameters of Overlap.Start and Overlap.End along the first segment
+	U       [2]float32       // parameters of Overlap.Start and Overlap.End along the second segment
+}
+
+// Point - point at which the segments meet, or the start of their overlap
+func (in Intersection) Point() point.Point {
+	return in.Overlap.Start
+}
+
+// Intersect - returns where two line segments meet: nowhere, at a point, or along an overlapping sub-segment
+// when they are collinear. Whether and how the segments meet is decided with exact orientation predicates, and
+// the ends of an overlap are always ends of the segments, so only the coordinates of a crossing point are
+// subject to float round-off.
+func (ls LineSegment) Intersect(secondLineSegment LineSegment) Intersection {
+	a1, a2, b1, b2 := ls.Start, ls.End, secondLineSegment.Start, secondLineSegment.End
+	if !robust.SegmentsIntersect(a1, a2, b1, b2) {
+		return Intersection{}
+	}
+	collinear := robust.Orient(a1, a2, b1) == robust.Collinear && robust.Orient(a1, a2, b2) == robust.Collinear &&
+		robust.Orient(b1, b2, a1) == robust.Collinear && robust.Orient(b1, b2, a2) == robust.Collinear
+	if !collinear {
+		meet, _ := ls.IntersectsLineSegmentRobust(secondLineSegment)
+		return ls.intersectionAt(secondLineSegment, meet, meet)
+	}
+
+	// collinear points are ordered along their line by comparing x, then y, so the shared part runs from
+	// the greater of the lesser ends to the lesser of the greater ends
+	aLow, aHigh := orderAlongLine(a1, a2)
+	bLow, bHigh := orderAlongLine(b1, b2)
+	low, high := aLow, aHigh
+	if alongLineLess(low, bLow) {
+		low = bLow
+	}
+	if alongLineLess(bHigh, high) {
+		high = bHigh
+	}
+	if alongLineLess(high, low) {
+		return Intersection{}
+	}
+	// keep direction of first segment
+	if alongLineLess(a2, a1) {
+		low, high = high, low
+	}
+	return ls.intersectionAt(secondLineSegment, low, high)
+}
+
+// intersectionAt - intersection of the segments along the shared part from start to end
+func (ls LineSegment) intersectionAt(secondLineSegment LineSegment, start, end point.Point) Intersection {
+	kind := IntersectionOverlap
+	if start == end {
+		kind = IntersectionPoint
+	}
+	return Intersection{
+		Kind:    kind,
+		Overlap: LineSegment{Start: start, End: end},
+		T:       [2]float32{ls.parameter(start), ls.parameter(end)},
+		U:       [2]float32{secondLineSegment.parameter(start), secondLineSegment.parameter(end)},
+	}
+}
+
+// parameter - position of the projection of p along the segment, clamped to the range 0 to 1
+func (ls LineSegment) parameter(p point.Point) float32 {
+	if p == ls.Start {
+		return 0
+	}
+	if p == ls.End {
+		return 1
+	}
+	dx, dy := float64(ls.End.X)-float64(ls.Start.X), float64(ls.End.Y)-float64(ls.Start.Y)
+	lengthSquared := dx*dx + dy*dy
+	if lengthSquared == 0 {
+		return 0
+	}
+	t := ((float64(p.X)-float64(ls.Start.X))*dx + (float64(p.Y)-float64(ls.Start.Y))*dy) / lengthSquared
+	return float32(math.Max(0, math.Min(1, t)))
+}
+
+// alongLineLess - boolean indicating whether a comes before b along a line, comparing x and then y
+func alongLineLess(a, b point.Point) bool {
+	return a.X < b.X || (a.X == b.X && a.Y < b.Y)
+}
+
+// orderAlongLine - ends of a segment in order along its line
+func orderAlongLine(a, b point.Point) (low, high point.Point) {
+	if alongLineLess(b, a) {
+		return b, a
+	}
+	return a, b
+}
@@ -0,0 +1,72 @@
+package line
+
+import (
+	"collision/point"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIntersect - test that Intersect reports crossings, touching ends and collinear overlaps
+func TestIntersect(t *testing.T) {
+	ls1 := LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 10, Y: 0}}
+
+	// proper crossing
+	in := ls1.Intersect(LineSegment{Start: point.Point{X: 4, Y: -2}, End: point.Point{X: 4, Y: 6}})
+	assert.Equal(t, IntersectionPoint, in.Kind)
+	assert.Equal(t, point.Point{X: 4, Y: 0}, in.Point())
+	assert.Equal(t, in.Overlap.Start, in.Overlap.End, "expect degenerate overlap for a point")
+	assert.InDelta(t, 0.4, in.T[0], 1e-6)
+	assert.InDelta(t, 0.25, in.U[0], 1e-6)
+
+	// no intersection
+	in = ls1.Intersect(LineSegment{Start: point.Point{X: 4, Y: 1}, End: point.Point{X: 4, Y: 6}})
+	assert.Equal(t, IntersectionNone, in.Kind)
+	assert.Equal(t, "none", in.Kind.String())
+
+	// collinear, overlapping along a stretch. The overlap runs in the direction of the first segment.
+	in = ls1.Intersect(LineSegment{Start: point.Point{X: 14, Y: 0}, End: point.Point{X: 6, Y: 0}})
+	assert.Equal(t, IntersectionOverlap, in.Kind)
+	assert.Equal(t, "overlap", in.Kind.String())
+	assert.Equal(t, LineSegment{Start: point.Point{X: 6, Y: 0}, End: point.Point{X: 10, Y: 0}}, in.Overlap)
+	assert.InDelta(t, 0.6, in.T[0], 1e-6)
+	assert.Equal(t, float32(1), in.T[1])
+	assert.Equal(t, float32(1), in.U[0])
+	assert.InDelta(t, 0.5, in.U[1], 1e-6)
+
+	// reversed first segment reverses the overlap
+	reversed := LineSegment{Start: ls1.End, End: ls1.Start}
+	in = reversed.Intersect(LineSegment{Start: point.Point{X: 2, Y: 0}, End: point.Point{X: 3, Y: 0}})
+	assert.Equal(t, IntersectionOverlap, in.Kind)
+	assert.Equal(t, LineSegment{Start: point.Point{X: 3, Y: 0}, End: point.Point{X: 2, Y: 0}}, in.Overlap)
+	assert.InDelta(t, 0.7, in.T[0], 1e-6)
+	assert.InDelta(t, 0.8, in.T[1], 1e-6)
+
+	// second segment lying wholly within the first, on a diagonal
+	diagonal := LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 4, Y: 4}}
+	in = diagonal.Intersect(LineSegment{Start: point.Point{X: 1, Y: 1}, End: point.Point{X: 3, Y: 3}})
+	assert.Equal(t, IntersectionOverlap, in.Kind)
+	assert.Equal(t, LineSegment{Start: point.Point{X: 1, Y: 1}, End: point.Point{X: 3, Y: 3}}, in.Overlap)
+	assert.Equal(t, [2]float32{0, 1}, in.U)
+
+	// collinear, touching end to end
+	in = ls1.Intersect(LineSegment{Start: point.Point{X: 10, Y: 0}, End: point.Point{X: 12, Y: 0}})
+	assert.Equal(t, IntersectionPoint, in.Kind)
+	assert.Equal(t, point.Point{X: 10, Y: 0}, in.Point())
+	assert.Equal(t, [2]float32{1, 1}, in.T)
+	assert.Equal(t, [2]float32{0, 0}, in.U)
+
+	// collinear with a gap
+	in = ls1.Intersect(LineSegment{Start: point.Point{X: 11, Y: 0}, End: point.Point{X: 12, Y: 0}})
+	assert.Equal(t, IntersectionNone, in.Kind)
+
+	// vertical collinear overlap is ordered by y
+	vertical := LineSegment{Start: point.Point{X: 1, Y: 5}, End: point.Point{X: 1, Y: 0}}
+	in = vertical.Intersect(LineSegment{Start: point.Point{X: 1, Y: 3}, End: point.Point{X: 1, Y: 8}})
+	assert.Equal(t, LineSegment{Start: point.Point{X: 1, Y: 5}, End: point.Point{X: 1, Y: 3}}, in.Overlap)
+
+	// zero length segment lying on the other segment
+	in = ls1.Intersect(LineSegment{Start: point.Point{X: 5, Y: 0}, End: point.Point{X: 5, Y: 0}})
+	assert.Equal(t, IntersectionPoint, in.Kind)
+	assert.Equal(t, point.Point{X: 5, Y: 0}, in.Point())
+}
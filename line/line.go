@@ -2,6 +2,7 @@ package line
 
 import (
 	"collision/point"
+	"collision/robust"
 )
 
 // LineSegment - defined by start and end points
@@ -20,7 +21,12 @@ func (ls LineSegment) Length() float32 {
 // Real life very near vertical line segments will return true.
 // Two points touching within global delta (zero length line) will return false.
 func (ls LineSegment) IsVertical() bool {
-	return ls.Start.SameX(ls.End) && !ls.Start.SameY(ls.End)
+	return ls.IsVerticalWithTolerance(point.DefaultTolerance)
+}
+
+// IsVerticalWithTolerance - boolean indicating vertical line, using the tolerance to check near equality of coords.
+func (ls LineSegment) IsVerticalWithTolerance(tol point.Tolerance) bool {
+	return ls.Start.SameXWithTolerance(ls.End, tol) && !ls.Start.SameYWithTolerance(ls.End, tol)
 }
 
 // IsHorizontal - boolean indicating horizontal line.
@@ -28,20 +34,58 @@ func (ls LineSegment) IsVertical() bool {
 // Real life very near horizonal line segments will return true.
 // Two points touching within global delta (zerolength line) will return false.
 func (ls LineSegment) IsHorizontal() bool {
-	return ls.Start.SameY(ls.End) && !ls.Start.SameX(ls.End)
+	return ls.IsHorizontalWithTolerance(point.DefaultTolerance)
+}
+
+// IsHorizontalWithTolerance - boolean indicating horizontal line, using the tolerance to check near equality of coords.
+func (ls LineSegment) IsHorizontalWithTolerance(tol point.Tolerance) bool {
+	return ls.Start.SameYWithTolerance(ls.End, tol) && !ls.Start.SameXWithTolerance(ls.End, tol)
 }
 
-// HasPoint - returns boolean indicating whether point lies on segment within delta
+// HasPoint - returns boolean indicating whether point lies on segment within easy delta
 func (ls LineSegment) HasPoint(p point.Point) bool {
+	return ls.HasPointWithTolerance(p, point.EasyTolerance)
+}
+
+// HasPointWithTolerance - returns boolean indicating whether point lies on segment under the tolerance
+func (ls LineSegment) HasPointWithTolerance(p point.Point, tol point.Tolerance) bool {
 	distanceFromPToStart := p.Distance(ls.Start)
 	distanceFromPToEnd := p.Distance(ls.End)
 	totalDistance := distanceFromPToStart + distanceFromPToEnd
-	return point.AreWithinEasyDelta(totalDistance, ls.Length())
+	return tol.AreWithin(totalDistance, ls.Length())
 }
 
 // IntersectsLineSegment - returns boolean indicating whether two line segments meet.
 // Also returns coordinates of intersection if true and Point(0,0) if false.
+// The parallel test uses global delta, while checking for overlap of parallel segments uses easy delta.
+// Where collinear segments overlap, only one end of the overlap is returned: use Intersect for all of it.
 func (ls LineSegment) IntersectsLineSegment(secondLineSegment LineSegment) (point.Point, bool) {
+	return ls.intersectsLineSegment(secondLineSegment, point.DefaultTolerance, point.EasyTolerance)
+}
+
+// IntersectsLineSegmentWithTolerance - as IntersectsLineSegment, but with every near equality decision
+// made under the single tolerance provided. Segments whose ends lie on the other segment under the
+// tolerance are also treated as meeting, at that end.
+func (ls LineSegment) IntersectsLineSegmentWithTolerance(secondLineSegment LineSegment, tol point.Tolerance) (point.Point, bool) {
+	if intersectionPoint, ok := ls.intersectsLineSegment(secondLineSegment, tol, tol); ok {
+		return intersectionPoint, true
+	}
+	for _, end := range []point.Point{secondLineSegment.Start, secondLineSegment.End} {
+		if ls.HasPointWithTolerance(end, tol) {
+			return end, true
+		}
+	}
+	for _, end := range []point.Point{ls.Start, ls.End} {
+		if secondLineSegment.HasPointWithTolerance(end, tol) {
+			return end, true
+		}
+	}
+	return point.Point{}, false
+}
+
+// intersectsLineSegment - find intersection of two line segments, using parallelTol to decide whether the
+// segments are parallel and onSegmentTol to decide whether the ends of parallel segments overlap.
+func (ls LineSegment) intersectsLineSegment(secondLineSegment LineSegment, parallelTol, onSegmentTol point.Tolerance) (point.Point, bool) {
 	x1, x2, x3, x4 := ls.Start.X, ls.End.X, secondLineSegment.Start.X, secondLineSegment.End.X
 	y1, y2, y3, y4 := ls.Start.Y, ls.End.Y, secondLineSegment.Start.Y, secondLineSegment.End.Y
 
@@ -49,19 +93,19 @@ func (ls LineSegment) IntersectsLineSegment(secondLineSegment LineSegment) (poin
 	denominator := ((y4-y3)*(x2-x1) - (x4-x3)*(y2-y1))
 
 	// if denominator is zero, then line segments are parallel
-	if point.AreWithinGlobalDelta(denominator, 0) {
+	if parallelTol.AreWithin(denominator, 0) {
 		// if either end of second line segment lies on first line segment, then the end is an intersection point
-		if ls.HasPoint(secondLineSegment.Start) {
+		if ls.HasPointWithTolerance(secondLineSegment.Start, onSegmentTol) {
 			return secondLineSegment.Start, true
 		}
-		if ls.HasPoint(secondLineSegment.End) {
+		if ls.HasPointWithTolerance(secondLineSegment.End, onSegmentTol) {
 			return secondLineSegment.End, true
 		}
 		// if either end of first line segment lines on second line segment, then the end is an intersection point
-		if secondLineSegment.HasPoint(ls.Start) {
+		if secondLineSegment.HasPointWithTolerance(ls.Start, onSegmentTol) {
 			return ls.Start, true
 		}
-		if secondLineSegment.HasPoint(ls.End) {
+		if secondLineSegment.HasPointWithTolerance(ls.End, onSegmentTol) {
 			return ls.End, true
 		}
 
@@ -87,3 +131,53 @@ func (ls LineSegment) IntersectsLineSegment(secondLineSegment LineSegment) (poin
 
 	return point.Point{X: intersectionX, Y: intersectionY}, true
 }
+
+// IntersectsLineSegmentRobust - as IntersectsLineSegment, but whether the segments meet is decided with exact
+// orientation predicates, so near parallel and near degenerate input always receives a consistent answer.
+// The coordinates of a crossing point are still subject to float round-off.
+func (ls LineSegment) IntersectsLineSegmentRobust(secondLineSegment LineSegment) (point.Point, bool) {
+	if !robust.SegmentsIntersect(ls.Start, ls.End, secondLineSegment.Start, secondLineSegment.End) {
+		return point.Point{}, false
+	}
+	// if an end of either segment lies on the other segment, then the end is an intersection point
+	for _, end := range []point.Point{secondLineSegment.Start, secondLineSegment.End} {
+		if robust.Orient(ls.Start, ls.End, end) == robust.Collinear && robust.OnSegment(ls.Start, ls.End, end) {
+			return end, true
+		}
+	}
+	for _, end := range []point.Point{ls.Start, ls.End} {
+		if robust.Orient(secondLineSegment.Start, secondLineSegment.End, end) == robust.Collinear && robust.OnSegment(secondLineSegment.Start, secondLineSegment.End, end) {
+			return end, true
+		}
+	}
+
+	// otherwise this is a proper crossing, so find coordinates of intersection point in double precision
+	x1, x2, x3, x4 := float64(ls.Start.X), float64(ls.End.X), float64(secondLineSegment.Start.X), float64(secondLineSegment.End.X)
+	y1, y2, y3, y4 := float64(ls.Start.Y), float64(ls.End.Y), float64(secondLineSegment.Start.Y), float64(secondLineSegment.End.Y)
+	denominator := ((y4-y3)*(x2-x1) - (x4-x3)*(y2-y1))
+	uA := ((x4-x3)*(y1-y3) - (y4-y3)*(x1-x3)) / denominator
+	return point.Point{X: float32(x1 + uA*(x2-x1)), Y: float32(y1 + uA*(y2-y1))}, true
+}
+
+// ClosestPoint - returns the point on the line segment nearest to p. Computed in double precision, so the
+// projection of p is clamped to the segment without round-off pushing it past either end.
+func (ls LineSegment) ClosestPoint(p point.Point) point.Point {
+	dx, dy := float64(ls.End.X)-float64(ls.Start.X), float64(ls.End.Y)-float64(ls.Start.Y)
+	lengthSquared := dx*dx + dy*dy
+	if lengthSquared == 0 {
+		return ls.Start
+	}
+	t := ((float64(p.X)-float64(ls.Start.X))*dx + (float64(p.Y)-float64(ls.Start.Y))*dy) / lengthSquared
+	if t <= 0 {
+		return ls.Start
+	}
+	if t >= 1 {
+		return ls.End
+	}
+	return point.Point{X: float32(float64(ls.Start.X) + t*dx), Y: float32(float64(ls.Start.Y) + t*dy)}
+}
+
+// DistanceToPoint - returns the distance from p to the nearest point of the line segment
+func (ls LineSegment) DistanceToPoint(p point.Point) float32 {
+	return ls.ClosestPoint(p).Distance(p)
+}
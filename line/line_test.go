@@ -108,3 +108,61 @@ func TestIntersectsLineSegment(t *testing.T) {
 	assert.Equal(t, expectedPoint, intersectionPoint, "lines should not intersect")
 
 }
+
+// TestIntersectsLineSegmentWithTolerance - test that a single tolerance governs both parallel and overlap tests
+func TestIntersectsLineSegmentWithTolerance(t *testing.T) {
+	// kilometre scale collinear segments meeting end to end
+	ls1 := LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 5000, Y: 0}}
+	ls2 := LineSegment{Start: point.Point{X: 5000.2, Y: 0}, End: point.Point{X: 9000, Y: 0}}
+
+	_, ok := ls1.IntersectsLineSegment(ls2)
+	assert.False(t, ok, "segments should not meet under default deltas")
+
+	intersectionPoint, ok := ls1.IntersectsLineSegmentWithTolerance(ls2, point.NewTolerance(0.5, 0))
+	assert.True(t, ok, "segments should meet under coarse tolerance")
+	assert.Equal(t, ls2.Start, intersectionPoint, "segments should meet at start of second segment")
+
+	ok = ls1.HasPointWithTolerance(point.Point{X: 2500, Y: 0.001}, point.NewTolerance(0, 0.000001))
+	assert.True(t, ok, "point should be on line under relative tolerance")
+
+	ls3 := LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 0.4, Y: 4}}
+	assert.False(t, ls3.IsVertical(), "should not be vertical")
+	assert.True(t, ls3.IsVerticalWithTolerance(point.NewTolerance(0.5, 0)), "should be vertical under coarse tolerance")
+}
+
+// TestIntersectsLineSegmentRobust - test for correct function of IntersectsLineSegmentRobust
+func TestIntersectsLineSegmentRobust(t *testing.T) {
+	ls1 := LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 10, Y: 10}}
+	ls2 := LineSegment{Start: point.Point{X: 0, Y: 1}, End: point.Point{X: 1, Y: 0}}
+	intersectionPoint, ok := ls1.IntersectsLineSegmentRobust(ls2)
+	assert.True(t, ok)
+	assert.Equal(t, point.Point{X: 0.5, Y: 0.5}, intersectionPoint, "lines should intersect at (0.5, 0.5)")
+
+	// near parallel segments that just miss
+	ls2 = LineSegment{Start: point.Point{X: 0, Y: 0.000001}, End: point.Point{X: 10, Y: 10.000001}}
+	_, ok = ls1.IntersectsLineSegmentRobust(ls2)
+	assert.False(t, ok, "near parallel lines should not intersect")
+
+	// touching at an end is reported exactly
+	ls2 = LineSegment{Start: point.Point{X: 3, Y: 3}, End: point.Point{X: 3, Y: -7}}
+	intersectionPoint, ok = ls1.IntersectsLineSegmentRobust(ls2)
+	assert.True(t, ok)
+	assert.Equal(t, ls2.Start, intersectionPoint, "lines should meet at start of second segment")
+}
+
+// TestClosestPoint - test that ClosestPoint and DistanceToPoint behave as expected
+func TestClosestPoint(t *testing.T) {
+	ls := LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 10, Y: 0}}
+	assert.Equal(t, point.Point{X: 4, Y: 0}, ls.ClosestPoint(point.Point{X: 4, Y: 3}), "expect projection onto segment")
+	assert.Equal(t, float32(3), ls.DistanceToPoint(point.Point{X: 4, Y: 3}))
+
+	// projections beyond either end are clamped
+	assert.Equal(t, ls.Start, ls.ClosestPoint(point.Point{X: -3, Y: 4}), "expect start of segment")
+	assert.Equal(t, float32(5), ls.DistanceToPoint(point.Point{X: -3, Y: 4}))
+	assert.Equal(t, ls.End, ls.ClosestPoint(point.Point{X: 12, Y: -1}), "expect end of segment")
+
+	// zero length segment behaves as a point
+	ls = LineSegment{Start: point.Point{X: 1, Y: 1}, End: point.Point{X: 1, Y: 1}}
+	assert.Equal(t, ls.Start, ls.ClosestPoint(point.Point{X: 4, Y: 5}))
+	assert.Equal(t, float32(5), ls.DistanceToPoint(point.Point{X: 4, Y: 5}))
+}
@@ -0,0 +1,84 @@
+package narrowphase
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"collision/proximity"
+)
+
+// intersect - boolean indicating whether shapes a and b intersect, with the points where a segment crosses the
+// other shape's boundary for the tests that find them. Pairs with a dedicated test in the shape packages use it,
+// either way round, and any other pair of supported shapes intersects if the distance between them is zero.
+func intersect(a, b any) ([]point.Point, bool, error) {
+	switch a := a.(type) {
+	case line.LineSegment:
+		switch b := b.(type) {
+		case line.LineSegment:
+			p, ok := a.IntersectsLineSegment(b)
+			if !ok {
+				return nil, false, nil
+			}
+			return []point.Point{p}, true, nil
+		case circle.Circle:
+			return nil, b.InstersectsLineSegment(a), nil
+		case *polygon.XYRectangle:
+			ps, ok := rectangleIntersectsLineSegment(b, a)
+			return ps, ok, nil
+		}
+	case circle.Circle:
+		switch b := b.(type) {
+		case circle.Circle:
+			return nil, a.CirclesIntersect(b), nil
+		case line.LineSegment:
+			return nil, a.InstersectsLineSegment(b), nil
+		case point.Point:
+			return nil, a.ContainsPoint(b), nil
+		}
+	case point.Point:
+		switch b := b.(type) {
+		case circle.Circle:
+			return nil, b.ContainsPoint(a), nil
+		case *polygon.XYRectangle:
+			return nil, b.ContainsPoint(a), nil
+		}
+	case *polygon.XYRectangle:
+		switch b := b.(type) {
+		case line.LineSegment:
+			ps, ok := rectangleIntersectsLineSegment(a, b)
+			return ps, ok, nil
+		case point.Point:
+			return nil, a.ContainsPoint(b), nil
+		}
+	}
+	distance, _, _, err := proximity.Distance(a, b)
+	if err != nil {
+		return nil, false, err
+	}
+	return nil, distance == 0, nil
+}
+
+// rectangleIntersectsLineSegment - points where a segment crosses the edges of a rectangle, and whether the
+// segment crosses or lies inside the solid rectangle
+func rectangleIntersectsLineSegment(r *polygon.XYRectangle, ls line.LineSegment) ([]point.Point, bool) {
+	ps, ok := r.IntersectsLineSegment(ls)
+	return ps, ok || r.ContainsPoint(ls.Start)
+}
+
+// populateEdges - fill in edges missing from rectangles and polygons, which their tests would otherwise fill in
+// themselves, racing with other tests of the same shape
+func populateEdges(shapes []any) {
+	for _, s := range shapes {
+		switch s := s.(type) {
+		case *polygon.XYRectangle:
+			if s != nil && len(s.Edges) != len(s.Vertices) {
+				s.PopulateEdges()
+			}
+		case *polygon.XYPolygon:
+			if s != nil && len(s.Vertices) > 0 && len(s.Edges) != len(s.Vertices) {
+				s.PopulateEdges()
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package narrowphase
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"collision/proximity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIntersect - test that each kind of pair is tested either way round, with solid circles, rectangles and
+// polygons
+func TestIntersect(t *testing.T) {
+	square, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 0, Y: 0}, {X: 4, Y: 4}})
+	assert.Nil(t, err)
+	triangle, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 10, Y: 0}, {X: 14, Y: 0}, {X: 12, Y: 4}})
+	assert.Nil(t, err)
+	c := circle.NewCircle(2, 2, 1)
+	across := line.LineSegment{Start: point.Point{X: -1, Y: 2}, End: point.Point{X: 5, Y: 2}}
+	inside := line.LineSegment{Start: point.Point{X: 1, Y: 1}, End: point.Point{X: 3, Y: 1}}
+	down := line.LineSegment{Start: point.Point{X: 0, Y: 5}, End: point.Point{X: 0, Y: -1}}
+
+	testCases := []struct {
+		name   string
+		a, b   any
+		points []point.Point
+		ok     bool
+	}{
+		{"segments cross", across, down, []point.Point{{X: 0, Y: 2}}, true},
+		{"segments apart", inside, down, nil, false},
+		{"segment across circle", across, c, nil, true},
+		{"circle around segment", c, inside, nil, true},
+		{"segment across rectangle", across, square, []point.Point{{X: 0, Y: 2}, {X: 4, Y: 2}}, true},
+		{"segment inside rectangle", square, inside, []point.Point{}, true},
+		{"circles", c, circle.NewCircle(4, 2, 1), nil, true},
+		{"circles apart", c, circle.NewCircle(5, 2, 1), nil, false},
+		{"point in circle", point.Point{X: 2, Y: 2.5}, c, nil, true},
+		{"circle around point", c, point.Point{X: 4, Y: 4}, nil, false},
+		{"point in rectangle", point.Point{X: 4, Y: 4}, square, nil, true},
+		{"rectangle around point", square, point.Point{X: 5, Y: 4}, nil, false},
+		{"circle in rectangle", c, square, nil, true},
+		{"polygon and segment", triangle, line.LineSegment{Start: point.Point{X: 12, Y: 1}, End: point.Point{X: 12, Y: 9}}, nil, true},
+		{"polygon and rectangle", triangle, square, nil, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			points, ok, err := intersect(tc.a, tc.b)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.points, points)
+		})
+	}
+
+	_, _, err = intersect(c, "circle")
+	assert.ErrorIs(t, err, proximity.ErrUnsupportedShape)
+}
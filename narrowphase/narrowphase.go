@@ -0,0 +1,153 @@
+// Package narrowphase tests candidate pairs of shapes, found by a broad phase, for intersection across a pool of
+// goroutines. Each pair is tested with the shape packages' own tests where there is one, such as
+// line.LineSegment.IntersectsLineSegment and circle.Circle.CirclesIntersect, and otherwise by the proximity
+// package. Circles, rectangles and polygons are solid.
+//
+// Results are in order of pair, whatever the number of workers and however the goroutines are scheduled, so
+// servers stepping in lockstep see the same results in the same order.
+package narrowphase
+
+import (
+	"cmp"
+	"collision/point"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultChunkSize - pairs a worker takes at a time, unless the pool sets another
+const DefaultChunkSize = 64
+
+// ErrPairIndex - pair refers to a shape that is not in the shapes tested
+var ErrPairIndex = errors.New("pair index out of range")
+
+// Pair - candidate pair of shapes, by their indices in the shapes tested
+type Pair struct {
+	A, B int
+}
+
+// ordered - the pair with its lower index first
+func (p Pair) ordered() Pair {
+	if p.B < p.A {
+		return Pair{A: p.B, B: p.A}
+	}
+	return p
+}
+
+// compare - order of pairs by first index then second
+func (p Pair) compare(q Pair) int {
+	if c := cmp.Compare(p.A, q.A); c != 0 {
+		return c
+	}
+	return cmp.Compare(p.B, q.B)
+}
+
+// Result - pair of shapes found to intersect
+type Result struct {
+	Pair
+	Points []point.Point // where a segment crosses the other shape's boundary, for segments against segments and rectangles
+}
+
+// Pool - workers testing pairs. The zero value uses a worker for each CPU.
+type Pool struct {
+	Workers   int // goroutines testing pairs, runtime.GOMAXPROCS if not positive
+	ChunkSize int // pairs a worker takes at a time, DefaultChunkSize if not positive
+}
+
+// NewPool - returns a pointer to a pool of workers, with the default chunk size
+func NewPool(workers int) *Pool {
+	return &Pool{Workers: workers, ChunkSize: DefaultChunkSize}
+}
+
+// Intersections - test candidate pairs across a pool with a worker for each CPU
+func Intersections(shapes []any, pairs []Pair) ([]Result, error) {
+	return (&Pool{}).Intersections(shapes, pairs)
+}
+
+// Intersections - test candidate pairs of shapes, returning the pairs that intersect in order of their lower then
+// higher index. A pair may be given either way round and more than once, and is tested and reported once, with
+// its lower index as A. A shape is not tested against itself. Edges missing from rectangles and polygons are
+// filled in before testing starts. Returns ErrPairIndex if a pair refers to a shape not in shapes, and otherwise
+// the error of the first pair, in order, holding a shape the proximity package does not accept.
+func (p *Pool) Intersections(shapes []any, pairs []Pair) ([]Result, error) {
+	candidates := make([]Pair, 0, len(pairs))
+	for _, pair := range pairs {
+		pair = pair.ordered()
+		if pair.A < 0 || pair.B >= len(shapes) {
+			return nil, fmt.Errorf("%w: %v with %d shapes", ErrPairIndex, pair, len(shapes))
+		}
+		if pair.A != pair.B {
+			candidates = append(candidates, pair)
+		}
+	}
+	slices.SortFunc(candidates, Pair.compare)
+	candidates = slices.Compact(candidates)
+	populateEdges(shapes)
+
+	// each pair's outcome has its own slot, so workers share nothing but the count of pairs taken
+	type outcome struct {
+		points []point.Point
+		ok     bool
+		err    error
+	}
+	outcomes := make([]outcome, len(candidates))
+	test := func(from, to int) {
+		for i := from; i < to; i++ {
+			pair := candidates[i]
+			o := &outcomes[i]
+			o.points, o.ok, o.err = intersect(shapes[pair.A], shapes[pair.B])
+		}
+	}
+	workers, chunk := p.workers(), p.chunkSize()
+	if workers == 1 || len(candidates) <= chunk {
+		test(0, len(candidates))
+	} else {
+		var taken atomic.Int64
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					to := int(taken.Add(int64(chunk)))
+					from := to - chunk
+					if from >= len(candidates) {
+						return
+					}
+					test(from, min(to, len(candidates)))
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	var results []Result
+	for i, o := range outcomes {
+		if o.err != nil {
+			return nil, o.err
+		}
+		if o.ok {
+			results = append(results, Result{Pair: candidates[i], Points: o.points})
+		}
+	}
+	return results, nil
+}
+
+// workers - number of goroutines to test pairs with
+func (p *Pool) workers() int {
+	if p.Workers > 0 {
+		return p.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// chunkSize - number of pairs a worker takes at a time
+func (p *Pool) chunkSize() int {
+	if p.ChunkSize > 0 {
+		return p.ChunkSize
+	}
+	return DefaultChunkSize
+}
@@ -0,0 +1,114 @@
+package narrowphase
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"collision/proximity"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomShapes - shapes of every supported kind scattered over a small area, so many of them overlap
+func randomShapes(t *testing.T, rng *rand.Rand, n int) []any {
+	t.Helper()
+	at := func() point.Point {
+		return point.Point{X: rng.Float32() * 20, Y: rng.Float32() * 20}
+	}
+	shapes := make([]any, n)
+	for i := range shapes {
+		p := at()
+		switch i % 5 {
+		case 0:
+			shapes[i] = p
+		case 1:
+			shapes[i] = line.LineSegment{Start: p, End: at()}
+		case 2:
+			shapes[i] = circle.NewCircleFromPoint(p, 0.5+rng.Float32()*2)
+		case 3:
+			r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{p, {X: p.X + 1 + rng.Float32()*3, Y: p.Y + 1 + rng.Float32()*3}})
+			assert.Nil(t, err)
+			r.Edges = nil
+			shapes[i] = r
+		case 4:
+			shapes[i] = &polygon.XYPolygon{Vertices: []point.Point{p, {X: p.X + 3, Y: p.Y}, {X: p.X + 1, Y: p.Y + 2}}}
+		}
+	}
+	return shapes
+}
+
+// TestIntersectionsDeterministic - test that results are the same, in the same order, whatever the number of
+// workers and the order and direction the pairs are given in
+func TestIntersectionsDeterministic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	shapes := randomShapes(t, rng, 200)
+	var pairs []Pair
+	for a := range shapes {
+		for b := a + 1; b < len(shapes); b++ {
+			pairs = append(pairs, Pair{A: a, B: b})
+		}
+	}
+	expected, err := NewPool(1).Intersections(shapes, pairs)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, expected)
+	assert.Less(t, len(expected), len(pairs))
+	for i := 1; i < len(expected); i++ {
+		assert.Negative(t, expected[i-1].Pair.compare(expected[i].Pair))
+	}
+
+	for _, workers := range []int{2, 3, 8, 32, 0} {
+		t.Run(fmt.Sprint(workers), func(t *testing.T) {
+			// shuffle, reverse and repeat pairs, as a broad phase might report them
+			given := append([]Pair{}, pairs...)
+			for i := 0; i < len(pairs); i += 7 {
+				given = append(given, Pair{A: pairs[i].B, B: pairs[i].A})
+			}
+			rng.Shuffle(len(given), func(i, j int) { given[i], given[j] = given[j], given[i] })
+			results, err := (&Pool{Workers: workers, ChunkSize: 5}).Intersections(shapes, given)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, results)
+		})
+	}
+}
+
+// TestIntersectionsMatchQueries - test that each result is the shapes' own test of the pair
+func TestIntersectionsMatchQueries(t *testing.T) {
+	a := line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 2, Y: 2}}
+	b := line.LineSegment{Start: point.Point{X: 0, Y: 2}, End: point.Point{X: 2, Y: 0}}
+	c := circle.NewCircle(5, 0, 1)
+	shapes := []any{a, b, c, circle.NewCircle(6, 0, 1), point.Point{X: 5, Y: 0.5}}
+	results, err := Intersections(shapes, []Pair{{A: 4, B: 2}, {A: 0, B: 1}, {A: 3, B: 2}, {A: 1, B: 3}, {A: 0, B: 1}, {A: 2, B: 2}})
+	assert.Nil(t, err)
+	p, _ := a.IntersectsLineSegment(b)
+	assert.Equal(t, []Result{
+		{Pair: Pair{A: 0, B: 1}, Points: []point.Point{p}},
+		{Pair: Pair{A: 2, B: 3}},
+		{Pair: Pair{A: 2, B: 4}},
+	}, results)
+
+	results, err = Intersections(shapes, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, results)
+}
+
+// TestIntersectionsErrors - test that pairs out of range are rejected, and that of several pairs with unsupported
+// shapes the first in order gives the error
+func TestIntersectionsErrors(t *testing.T) {
+	shapes := []any{circle.NewCircle(0, 0, 1), "circle", &polygon.XYPolygon{}, circle.NewCircle(1, 0, 1)}
+	_, err := Intersections(shapes, []Pair{{A: 0, B: 3}, {A: 0, B: 4}})
+	assert.ErrorIs(t, err, ErrPairIndex)
+	_, err = Intersections(shapes, []Pair{{A: -1, B: 0}})
+	assert.ErrorIs(t, err, ErrPairIndex)
+
+	for _, workers := range []int{1, 4} {
+		pool := &Pool{Workers: workers, ChunkSize: 1}
+		_, err = pool.Intersections(shapes, []Pair{{A: 3, B: 2}, {A: 3, B: 0}, {A: 3, B: 1}})
+		assert.ErrorIs(t, err, proximity.ErrUnsupportedShape)
+		_, err = pool.Intersections(shapes, []Pair{{A: 3, B: 2}, {A: 0, B: 3}, {A: 2, B: 0}})
+		assert.ErrorIs(t, err, polygon.ErrDimension)
+	}
+}
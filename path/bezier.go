@@ -0,0 +1,175 @@
+package path
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"math"
+)
+
+// DefaultFlatness - maximum distance between a curve and the polyline approximating it in collision tests
+const DefaultFlatness = 1e-3
+
+// maxSubdivisionDepth - limit on halving a curve while flattening, so at most 2^16 edges are produced
+const maxSubdivisionDepth = 16
+
+// Curve - a path that can be evaluated along its length and flattened into a Polyline
+type Curve interface {
+	// PointAt - point at parameter t, running from 0 at the start of the curve to 1 at its end
+	PointAt(t float32) point.Point
+	// Flatten - polyline from start to end of curve, never further than flatness from it
+	Flatten(flatness float32) *Polyline
+}
+
+// ensure interface is implemented
+var _ Curve = QuadraticBezier{}
+var _ Curve = CubicBezier{}
+
+// QuadraticBezier - curve from Start to End, pulled towards a single Control point
+type QuadraticBezier struct {
+	Start   point.Point // start point
+	Control point.Point // control point, which the curve does not in general pass through
+	End     point.Point // end point
+}
+
+// CubicBezier - curve from Start to End, leaving Start towards Control1 and arriving at End from Control2
+type CubicBezier struct {
+	Start    point.Point // start point
+	Control1 point.Point // first control point
+	Control2 point.Point // second control point
+	End      point.Point // end point
+}
+
+// PointAt - point on curve at parameter t
+func (q QuadraticBezier) PointAt(t float32) point.Point {
+	u, v := float64(t), 1-float64(t)
+	return weighted([]point.Point{q.Start, q.Control, q.End}, []float64{v * v, 2 * v * u, u * u})
+}
+
+// Flatten - polyline approximating the curve, never further than flatness from it. Straighter parts of the
+// curve are approximated with fewer edges. A flatness that is not positive is replaced by DefaultFlatness.
+func (q QuadraticBezier) Flatten(flatness float32) *Polyline {
+	vertices := []point.Point{q.Start}
+	vertices = flattenQuadratic(q, flatnessOrDefault(flatness), 0, vertices)
+	p := &Polyline{Vertices: vertices}
+	p.PopulateEdges()
+	return p
+}
+
+// IntersectsLineSegment - approximate intersection points, in order along the curve, and boolean indicating
+// whether the curve, flattened to DefaultFlatness, intersects a line segment
+func (q QuadraticBezier) IntersectsLineSegment(l line.LineSegment) ([]point.Point, bool) {
+	return q.Flatten(DefaultFlatness).IntersectsLineSegment(l)
+}
+
+// IntersectsCircle - boolean indicating whether the curve, flattened to DefaultFlatness, meets the circle
+func (q QuadraticBezier) IntersectsCircle(c circle.Circle) bool {
+	return q.Flatten(DefaultFlatness).IntersectsCircle(c)
+}
+
+// IntersectsRectangle - boolean indicating whether the curve, flattened to DefaultFlatness, meets the
+// XYRectangle
+func (q QuadraticBezier) IntersectsRectangle(r *polygon.XYRectangle) bool {
+	return q.Flatten(DefaultFlatness).IntersectsRectangle(r)
+}
+
+// PointAt - point on curve at parameter t
+func (c CubicBezier) PointAt(t float32) point.Point {
+	u, v := float64(t), 1-float64(t)
+	return weighted([]point.Point{c.Start, c.Control1, c.Control2, c.End}, []float64{v * v * v, 3 * v * v * u, 3 * v * u * u, u * u * u})
+}
+
+// Flatten - polyline approximating the curve, never further than flatness from it. Straighter parts of the
+// curve are approximated with fewer edges. A flatness that is not positive is replaced by DefaultFlatness.
+func (c CubicBezier) Flatten(flatness float32) *Polyline {
+	vertices := []point.Point{c.Start}
+	vertices = flattenCubic(c, flatnessOrDefault(flatness), 0, vertices)
+	p := &Polyline{Vertices: vertices}
+	p.PopulateEdges()
+	return p
+}
+
+// IntersectsLineSegment - approximate intersection points, in order along the curve, and boolean indicating
+// whether the curve, flattened to DefaultFlatness, intersects a line segment
+func (c CubicBezier) IntersectsLineSegment(l line.LineSegment) ([]point.Point, bool) {
+	return c.Flatten(DefaultFlatness).IntersectsLineSegment(l)
+}
+
+// IntersectsCircle - boolean indicating whether the curve, flattened to DefaultFlatness, meets the circle
+func (c CubicBezier) IntersectsCircle(circ circle.Circle) bool {
+	return c.Flatten(DefaultFlatness).IntersectsCircle(circ)
+}
+
+// IntersectsRectangle - boolean indicating whether the curve, flattened to DefaultFlatness, meets the
+// XYRectangle
+func (c CubicBezier) IntersectsRectangle(r *polygon.XYRectangle) bool {
+	return c.Flatten(DefaultFlatness).IntersectsRectangle(r)
+}
+
+// split - halves of a quadratic curve, found by de Casteljau subdivision at t = 0.5
+func (q QuadraticBezier) split() (left, right QuadraticBezier) {
+	a, b := lerp(q.Start, q.Control, 0.5), lerp(q.Control, q.End, 0.5)
+	middle := lerp(a, b, 0.5)
+	return QuadraticBezier{Start: q.Start, Control: a, End: middle}, QuadraticBezier{Start: middle, Control: b, End: q.End}
+}
+
+// split - halves of a cubic curve, found by de Casteljau subdivision at t = 0.5
+func (c CubicBezier) split() (left, right CubicBezier) {
+	a, b, d := lerp(c.Start, c.Control1, 0.5), lerp(c.Control1, c.Control2, 0.5), lerp(c.Control2, c.End, 0.5)
+	ab, bd := lerp(a, b, 0.5), lerp(b, d, 0.5)
+	middle := lerp(ab, bd, 0.5)
+	return CubicBezier{Start: c.Start, Control1: a, Control2: ab, End: middle}, CubicBezier{Start: middle, Control1: bd, Control2: d, End: c.End}
+}
+
+// flattenQuadratic - append vertices after the start of a quadratic curve. The curve strays from its chord by
+// at most half the distance of its control point from the chord, so it is split until that is within flatness.
+func flattenQuadratic(q QuadraticBezier, flatness float64, depth int, vertices []point.Point) []point.Point {
+	chord := line.LineSegment{Start: q.Start, End: q.End}
+	if depth >= maxSubdivisionDepth || float64(chord.DistanceToPoint(q.Control))/2 <= flatness {
+		return append(vertices, q.End)
+	}
+	left, right := q.split()
+	vertices = flattenQuadratic(left, flatness, depth+1, vertices)
+	return flattenQuadratic(right, flatness, depth+1, vertices)
+}
+
+// flattenCubic - append vertices after the start of a cubic curve. The curve strays from its chord by at most
+// three quarters of the greater distance of its control points from the chord, so it is split until that is
+// within flatness.
+func flattenCubic(c CubicBezier, flatness float64, depth int, vertices []point.Point) []point.Point {
+	chord := line.LineSegment{Start: c.Start, End: c.End}
+	deviation := math.Max(float64(chord.DistanceToPoint(c.Control1)), float64(chord.DistanceToPoint(c.Control2))) * 3 / 4
+	if depth >= maxSubdivisionDepth || deviation <= flatness {
+		return append(vertices, c.End)
+	}
+	left, right := c.split()
+	vertices = flattenCubic(left, flatness, depth+1, vertices)
+	return flattenCubic(right, flatness, depth+1, vertices)
+}
+
+// flatnessOrDefault - flatness as a double, replaced by DefaultFlatness if not positive
+func flatnessOrDefault(flatness float32) float64 {
+	if flatness <= 0 {
+		return DefaultFlatness
+	}
+	return float64(flatness)
+}
+
+// lerp - point a fraction t of the way from a to b, computed in double precision
+func lerp(a, b point.Point, t float64) point.Point {
+	return point.Point{
+		X: float32(float64(a.X) + t*(float64(b.X)-float64(a.X))),
+		Y: float32(float64(a.Y) + t*(float64(b.Y)-float64(a.Y))),
+	}
+}
+
+// weighted - sum of points each multiplied by its weight, computed in double precision
+func weighted(points []point.Point, weights []float64) point.Point {
+	var x, y float64
+	for i, p := range points {
+		x += weights[i] * float64(p.X)
+		y += weights[i] * float64(p.Y)
+	}
+	return point.Point{X: float32(x), Y: float32(y)}
+}
@@ -0,0 +1,80 @@
+package path
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPointAt - test that curves are evaluated as expected
+func TestPointAt(t *testing.T) {
+	q := QuadraticBezier{Start: point.Point{X: 0, Y: 0}, Control: point.Point{X: 1, Y: 2}, End: point.Point{X: 2, Y: 0}}
+	assert.Equal(t, q.Start, q.PointAt(0))
+	assert.Equal(t, point.Point{X: 1, Y: 1}, q.PointAt(0.5))
+	assert.Equal(t, q.End, q.PointAt(1))
+
+	c := CubicBezier{Start: point.Point{X: 0, Y: 0}, Control1: point.Point{X: 0, Y: 4}, Control2: point.Point{X: 4, Y: 4}, End: point.Point{X: 4, Y: 0}}
+	assert.Equal(t, c.Start, c.PointAt(0))
+	assert.Equal(t, point.Point{X: 2, Y: 3}, c.PointAt(0.5))
+	assert.Equal(t, c.End, c.PointAt(1))
+}
+
+// TestFlatten - test that flattening stays within flatness and adapts to curvature
+func TestFlatten(t *testing.T) {
+	curves := []Curve{
+		QuadraticBezier{Start: point.Point{X: 0, Y: 0}, Control: point.Point{X: 5, Y: 10}, End: point.Point{X: 10, Y: 0}},
+		CubicBezier{Start: point.Point{X: 0, Y: 0}, Control1: point.Point{X: 0, Y: 10}, Control2: point.Point{X: 10, Y: -10}, End: point.Point{X: 10, Y: 0}},
+	}
+	for _, curve := range curves {
+		coarse, fine := curve.Flatten(0.1), curve.Flatten(0.001)
+		assert.Less(t, len(coarse.Vertices), len(fine.Vertices), "expect finer flattening to need more edges")
+		assert.Equal(t, curve.PointAt(0), fine.Vertices[0], "expect polyline to start at start of curve")
+		assert.Equal(t, curve.PointAt(1), fine.Vertices[len(fine.Vertices)-1], "expect polyline to end at end of curve")
+
+		// sample the curve, each sample must lie within flatness of the polyline
+		for i := 0; i <= 100; i++ {
+			sample := curve.PointAt(float32(i) / 100)
+			nearest := float32(1e9)
+			for _, edge := range coarse.Edges {
+				if d := edge.DistanceToPoint(sample); d < nearest {
+					nearest = d
+				}
+			}
+			assert.LessOrEqual(t, nearest, float32(0.1+1e-5), "expect curve within flatness of polyline")
+		}
+	}
+
+	// straight curve needs a single edge
+	straight := CubicBezier{Start: point.Point{X: 0, Y: 0}, Control1: point.Point{X: 1, Y: 1}, Control2: point.Point{X: 2, Y: 2}, End: point.Point{X: 3, Y: 3}}
+	assert.Len(t, straight.Flatten(0.01).Edges, 1)
+	assert.Equal(t, len(straight.Flatten(0).Vertices), len(straight.Flatten(DefaultFlatness).Vertices), "expect default flatness")
+}
+
+// TestCurveIntersections - test collision of curves with segments, circles and rectangles
+func TestCurveIntersections(t *testing.T) {
+	// arch from (0, 0) to (2, 0) peaking at (1, 1)
+	q := QuadraticBezier{Start: point.Point{X: 0, Y: 0}, Control: point.Point{X: 1, Y: 2}, End: point.Point{X: 2, Y: 0}}
+	points, ok := q.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: -1, Y: 0.75}, End: point.Point{X: 3, Y: 0.75}})
+	assert.True(t, ok)
+	assert.Len(t, points, 2, "expect line to cross both sides of arch")
+	// y = 2t(1-t) = 0.75 at t = 0.5 ± 0.25, where x = 2t
+	assert.InDelta(t, 0.5, points[0].X, 1e-2)
+	assert.InDelta(t, 1.5, points[1].X, 1e-2)
+	_, ok = q.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: -1, Y: 1.1}, End: point.Point{X: 3, Y: 1.1}})
+	assert.False(t, ok, "expect line above arch")
+
+	assert.True(t, q.IntersectsCircle(circle.NewCircle(1, 1.5, 0.51)), "expect circle touching peak")
+	assert.False(t, q.IntersectsCircle(circle.NewCircle(1, 0.4, 0.3)), "expect circle under arch")
+
+	c := CubicBezier{Start: point.Point{X: 0, Y: 0}, Control1: point.Point{X: 0, Y: 4}, Control2: point.Point{X: 4, Y: 4}, End: point.Point{X: 4, Y: 0}}
+	r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 1.5, Y: 2.9}, {X: 2.5, Y: 4}})
+	assert.Nil(t, err)
+	assert.True(t, c.IntersectsRectangle(r), "expect peak of curve to enter rectangle")
+	r, err = polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 1.5, Y: 3.1}, {X: 2.5, Y: 4}})
+	assert.Nil(t, err)
+	assert.False(t, c.IntersectsRectangle(r), "expect curve to pass under rectangle")
+}
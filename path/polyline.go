@@ -0,0 +1,107 @@
+// Package path provides open paths: polylines and quadratic and cubic Bezier curves. Curves are flattened
+// adaptively into polylines for collision tests against segments, circles and rectangles.
+package path
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"errors"
+)
+
+// ErrDimension - polyline has fewer than two vertices
+var ErrDimension = errors.New("polyline requires at least 2 points")
+
+// Polyline - open chain of line segments joining a series of points
+type Polyline struct {
+	Vertices []point.Point      // array of vertices - must be present
+	Edges    []line.LineSegment // array of line segments - need not be present, but will be populated if necessary
+}
+
+// NewPolyline - returns a pointer to a Polyline through the vertices, with edges populated. Returns error if
+// fewer than two vertices are provided.
+func NewPolyline(vertices []point.Point) (*Polyline, error) {
+	if len(vertices) < 2 {
+		return &Polyline{}, ErrDimension
+	}
+	p := &Polyline{Vertices: append([]point.Point{}, vertices...)}
+	p.PopulateEdges()
+	return p, nil
+}
+
+// PopulateEdges - populate the edges of a Polyline, each vertex joined to the next
+func (p *Polyline) PopulateEdges() {
+	if len(p.Vertices) < 2 {
+		p.Edges = nil
+		return
+	}
+	p.Edges = make([]line.LineSegment, len(p.Vertices)-1)
+	for i := range p.Edges {
+		p.Edges[i] = line.LineSegment{Start: p.Vertices[i], End: p.Vertices[i+1]}
+	}
+}
+
+// Length - total length of edges of a Polyline
+func (p *Polyline) Length() float32 {
+	p.ensureEdges()
+	var length float32
+	for _, edge := range p.Edges {
+		length += edge.Length()
+	}
+	return length
+}
+
+// IntersectsLineSegment - array of intersection points, in order along the polyline, and boolean indicating
+// whether a Polyline intersects a line segment. A crossing at a vertex shared by two edges is reported once.
+func (p *Polyline) IntersectsLineSegment(l line.LineSegment) ([]point.Point, bool) {
+	p.ensureEdges()
+	hit := false
+	intersections := make([]point.Point, 0, 1)
+	for _, edge := range p.Edges {
+		intersection, ok := edge.IntersectsLineSegment(l)
+		if !ok {
+			continue
+		}
+		hit = true
+		// the end of one edge is the start of the next, so a crossing there is found twice in a row
+		if len(intersections) > 0 && intersections[len(intersections)-1].AreTouching(intersection) {
+			continue
+		}
+		intersections = append(intersections, intersection)
+	}
+	return intersections, hit
+}
+
+// IntersectsCircle - boolean indicating whether any point of a Polyline lies in the circle
+func (p *Polyline) IntersectsCircle(c circle.Circle) bool {
+	p.ensureEdges()
+	for _, edge := range p.Edges {
+		if c.InstersectsLineSegment(edge) {
+			return true
+		}
+	}
+	return false
+}
+
+// IntersectsRectangle - boolean indicating whether any point of a Polyline lies in the XYRectangle
+func (p *Polyline) IntersectsRectangle(r *polygon.XYRectangle) bool {
+	p.ensureEdges()
+	// a polyline lying wholly inside the rectangle does not meet its edges
+	if len(p.Vertices) > 0 && r.ContainsPoint(p.Vertices[0]) {
+		return true
+	}
+	for _, edge := range p.Edges {
+		if _, ok := r.IntersectsLineSegment(edge); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureEdges - populate edges if they are missing or out of step with vertices
+func (p *Polyline) ensureEdges() {
+	if len(p.Edges) != len(p.Vertices)-1 {
+		p.PopulateEdges()
+	}
+}
@@ -0,0 +1,62 @@
+package path
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewPolyline - test that NewPolyline behaves as expected
+func TestNewPolyline(t *testing.T) {
+	p, err := NewPolyline([]point.Point{{X: 0, Y: 0}, {X: 3, Y: 4}, {X: 3, Y: 10}})
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, []line.LineSegment{
+		{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 3, Y: 4}},
+		{Start: point.Point{X: 3, Y: 4}, End: point.Point{X: 3, Y: 10}},
+	}, p.Edges)
+	assert.Equal(t, float32(11), p.Length())
+
+	_, err = NewPolyline([]point.Point{{X: 0, Y: 0}})
+	assert.ErrorIs(t, err, ErrDimension)
+}
+
+// TestPolylineIntersectsLineSegment - test that IntersectsLineSegment behaves as expected
+func TestPolylineIntersectsLineSegment(t *testing.T) {
+	// zigzag crossed by a horizontal line
+	p := &Polyline{Vertices: []point.Point{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 0}, {X: 3, Y: 2}}}
+	points, ok := p.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: -1, Y: 1}, End: point.Point{X: 4, Y: 1}})
+	assert.True(t, ok)
+	assert.Equal(t, []point.Point{{X: 0.5, Y: 1}, {X: 1.5, Y: 1}, {X: 2.5, Y: 1}}, points, "expect crossings in order along polyline")
+
+	// crossing at a shared vertex is reported once
+	points, ok = p.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: 1, Y: 3}, End: point.Point{X: 1, Y: 1}})
+	assert.True(t, ok)
+	assert.Equal(t, []point.Point{{X: 1, Y: 2}}, points)
+
+	_, ok = p.IntersectsLineSegment(line.LineSegment{Start: point.Point{X: -1, Y: 3}, End: point.Point{X: 4, Y: 3}})
+	assert.False(t, ok)
+}
+
+// TestPolylineIntersectsCircle - test that IntersectsCircle behaves as expected
+func TestPolylineIntersectsCircle(t *testing.T) {
+	p := &Polyline{Vertices: []point.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}}}
+	assert.True(t, p.IntersectsCircle(circle.NewCircle(5, 1, 2)))
+	assert.True(t, p.IntersectsCircle(circle.NewCircle(11, 5, 1)), "expect circle touching second edge")
+	assert.False(t, p.IntersectsCircle(circle.NewCircle(5, 5, 2)), "expect circle in corner of open chain")
+}
+
+// TestPolylineIntersectsRectangle - test that IntersectsRectangle behaves as expected
+func TestPolylineIntersectsRectangle(t *testing.T) {
+	r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 0, Y: 0}, {X: 4, Y: 4}})
+	assert.Nil(t, err)
+	inside := &Polyline{Vertices: []point.Point{{X: 1, Y: 1}, {X: 2, Y: 3}, {X: 3, Y: 1}}}
+	assert.True(t, inside.IntersectsRectangle(r), "expect polyline inside rectangle")
+	crossing := &Polyline{Vertices: []point.Point{{X: -1, Y: 2}, {X: 5, Y: 2}}}
+	assert.True(t, crossing.IntersectsRectangle(r), "expect polyline crossing rectangle")
+	outside := &Polyline{Vertices: []point.Point{{X: 5, Y: 0}, {X: 5, Y: 5}, {X: 0, Y: 5}}}
+	assert.False(t, outside.IntersectsRectangle(r), "expect polyline around rectangle")
+}
@@ -0,0 +1,217 @@
+package physics
+
+// BodyType - how a body responds to forces and contacts
+type BodyType int
+
+const (
+	StaticBody    BodyType = iota // never moves
+	DynamicBody                   // moved by gravity, forces and contacts
+	KinematicBody                 // moved only by its own velocity, pushing dynamic bodies without being pushed back
+)
+
+// BodyID - identifier of a body, unique within its world and assigned in order of addition
+type BodyID uint32
+
+// Default material of new bodies
+const (
+	DefaultFriction    = 0.2 // coefficient of friction
+	DefaultRestitution = 0   // bounciness, 0 for no bounce and 1 for a perfectly elastic collision
+)
+
+// Body - rigid body with a single shape. Position is the centre of mass of the shape, and the shape is held in
+// body coordinates relative to it.
+type Body struct {
+	id              BodyID
+	world           *World
+	Type            BodyType
+	shape           Shape
+	Position        Vec2    // position of centre of mass in world coordinates
+	Angle           float64 // rotation in radians, counterclockwise
+	Velocity        Vec2    // linear velocity of centre of mass
+	AngularVelocity float64 // angular velocity in radians per second, counterclockwise
+	Restitution     float64 // bounciness, the greater of the two bodies' values is used for a contact
+	Friction        float64 // coefficient of friction, the geometric mean of the two bodies' values is used
+	Filter          Filter  // which other bodies this one collides with
+	Sensor          bool    // report bodies overlapping the shape instead of colliding with them
+	AllowSleep      bool    // let the body fall asleep when it comes to rest
+	UserData        any     // anything the caller wishes to associate with the body
+	mass            float64
+	inertia         float64
+	invMass         float64
+	invInertia      float64
+	force           Vec2
+	torque          float64
+	joints          []Joint // joints attaching the body, while it is in a world
+	awake           bool
+	sleepTime       float64 // seconds the body has been at rest
+}
+
+// NewBody - returns a pointer to a body of the given type with the shape, which is given in world coordinates.
+// The body is placed at the centroid of the shape, and dynamic bodies are given the mass and rotational inertia
+// of the shape at the given density. Static and kinematic bodies have infinite mass whatever the density.
+func NewBody(bodyType BodyType, shape Shape, density float64) *Body {
+	mass, inertia, centroid := shape.massData(density)
+	b := &Body{
+		Type:        bodyType,
+		shape:       shape.translated(centroid.Neg()),
+		Position:    centroid,
+		Restitution: DefaultRestitution,
+		Friction:    DefaultFriction,
+		Filter:      DefaultFilter,
+		AllowSleep:  true,
+		awake:       true,
+	}
+	if bodyType == DynamicBody {
+		b.SetMassData(mass, inertia)
+	}
+	return b
+}
+
+// ID - identifier of body, zero until it is added to a world. A removed body keeps its ID, and is given a new
+// one if it is added again.
+func (b *Body) ID() BodyID {
+	return b.id
+}
+
+// Shape - shape of body in body coordinates
+func (b *Body) Shape() Shape {
+	return b.shape
+}
+
+// Mass - mass of body, zero for infinite mass
+func (b *Body) Mass() float64 {
+	return b.mass
+}
+
+// Inertia - rotational inertia of body about its centre of mass, zero for infinite inertia
+func (b *Body) Inertia() float64 {
+	return b.inertia
+}
+
+// SetMassData - override mass and rotational inertia of a dynamic body. A value that is not positive is treated
+// as infinite, so a body with zero inertia cannot be rotated by contacts.
+func (b *Body) SetMassData(mass, inertia float64) {
+	if b.Type != DynamicBody {
+		return
+	}
+	b.mass, b.invMass = mass, 0
+	if mass > 0 {
+		b.invMass = 1 / mass
+	} else {
+		b.mass = 0
+	}
+	b.inertia, b.invInertia = inertia, 0
+	if inertia > 0 {
+		b.invInertia = 1 / inertia
+	} else {
+		b.inertia = 0
+	}
+}
+
+// Awake - boolean indicating whether the body is simulated. A dynamic body that has been at rest for long
+// enough falls asleep, and is neither moved nor checked for contacts until something wakes it.
+func (b *Body) Awake() bool {
+	return b.awake
+}
+
+// SetAwake - wake a body or put a dynamic body to sleep. A sleeping body is woken by forces, impulses and
+// bodies that touch it, but not by changes made directly to its position or velocity, which should be followed
+// by waking it.
+func (b *Body) SetAwake(awake bool) {
+	if awake {
+		b.awake, b.sleepTime = true, 0
+		return
+	}
+	if b.Type != DynamicBody {
+		return
+	}
+	b.awake, b.sleepTime = false, 0
+	b.Velocity, b.AngularVelocity = Vec2{}, 0
+	b.clearForces()
+}
+
+// ApplyForce - accumulate a force at a point in world coordinates, applied over the next step
+func (b *Body) ApplyForce(force, at Vec2) {
+	if b.Type != DynamicBody {
+		return
+	}
+	b.SetAwake(true)
+	b.force = b.force.Add(force)
+	b.torque += at.Sub(b.Position).Cross(force)
+}
+
+// ApplyForceToCentre - accumulate a force at the centre of mass, applied over the next step
+func (b *Body) ApplyForceToCentre(force Vec2) {
+	if b.Type != DynamicBody {
+		return
+	}
+	b.SetAwake(true)
+	b.force = b.force.Add(force)
+}
+
+// ApplyTorque - accumulate a torque, applied over the next step
+func (b *Body) ApplyTorque(torque float64) {
+	if b.Type != DynamicBody {
+		return
+	}
+	b.SetAwake(true)
+	b.torque += torque
+}
+
+// ApplyImpulse - change velocity immediately by an impulse at a point in world coordinates
+func (b *Body) ApplyImpulse(impulse, at Vec2) {
+	if b.Type != DynamicBody {
+		return
+	}
+	b.SetAwake(true)
+	b.applyImpulse(impulse, at.Sub(b.Position))
+}
+
+// WorldPoint - point in body coordinates transformed to world coordinates
+func (b *Body) WorldPoint(local Vec2) Vec2 {
+	return b.Position.Add(newRotation(b.Angle).apply(local))
+}
+
+// LocalPoint - point in world coordinates transformed to body coordinates
+func (b *Body) LocalPoint(world Vec2) Vec2 {
+	return newRotation(b.Angle).inverse(world.Sub(b.Position))
+}
+
+// VelocityAt - velocity of the point of the body at a point in world coordinates
+func (b *Body) VelocityAt(at Vec2) Vec2 {
+	return b.Velocity.Add(crossScalar(b.AngularVelocity, at.Sub(b.Position)))
+}
+
+// AABB - axis aligned bounding box of body's shape at its current position
+func (b *Body) AABB() AABB {
+	return b.shape.aabb(b.Position, newRotation(b.Angle))
+}
+
+// applyImpulse - change velocity by an impulse at offset r from the centre of mass
+func (b *Body) applyImpulse(impulse, r Vec2) {
+	b.Velocity = b.Velocity.Add(impulse.Scale(b.invMass))
+	b.AngularVelocity += b.invInertia * r.Cross(impulse)
+}
+
+// moving - boolean indicating whether the body may move in the next step: an awake dynamic body, or a
+// kinematic body with a velocity
+func (b *Body) moving() bool {
+	switch b.Type {
+	case DynamicBody:
+		return b.awake
+	case KinematicBody:
+		return b.Velocity != (Vec2{}) || b.AngularVelocity != 0
+	}
+	return false
+}
+
+// resting - boolean indicating whether the body is static or a sleeping dynamic body, so cannot have moved
+// since the last step
+func (b *Body) resting() bool {
+	return b.Type == StaticBody || b.Type == DynamicBody && !b.awake
+}
+
+// clearForces - forget forces and torques accumulated for the step just taken
+func (b *Body) clearForces() {
+	b.force, b.torque = Vec2{}, 0
+}
@@ -0,0 +1,93 @@
+package physics
+
+import (
+	"slices"
+)
+
+// AABB - axis aligned bounding box
+type AABB struct {
+	Min Vec2 // corner with least coordinates
+	Max Vec2 // corner with greatest coordinates
+}
+
+// Overlaps - boolean indicating whether two boxes share any point, touching counting as overlap
+func (a AABB) Overlaps(b AABB) bool {
+	return a.Min.X <= b.Max.X && b.Min.X <= a.Max.X && a.Min.Y <= b.Max.Y && b.Min.Y <= a.Max.Y
+}
+
+// pair - two bodies whose boxes overlap, the one with the lower ID first
+type pair struct {
+	a, b *Body
+}
+
+// pairKey - identifier of a pair of bodies, the lower ID in the high bits
+type pairKey uint64
+
+// key - identifier of pair
+func (p pair) key() pairKey {
+	return pairKey(p.a.id)<<32 | pairKey(p.b.id)
+}
+
+// newPair - pair with bodies in order of ID
+func newPair(a, b *Body) pair {
+	if b.id < a.id {
+		a, b = b, a
+	}
+	return pair{a: a, b: b}
+}
+
+// proxy - body and its box, for sorting along x
+type proxy struct {
+	body *Body
+	box  AABB
+}
+
+// sweepAndPrune - pairs of bodies with overlapping boxes, found by sorting boxes on their least x and sweeping
+// along x with a list of boxes still open. Pairs rejected by accept, which is given bodies in order of ID, are
+// skipped. Pairs are returned sorted by key, so the result does not depend on the order bodies were given in.
+func sweepAndPrune(bodies []*Body, accept func(a, b *Body) bool) []pair {
+	proxies := make([]proxy, len(bodies))
+	for i, b := range bodies {
+		proxies[i] = proxy{body: b, box: b.AABB()}
+	}
+	slices.SortFunc(proxies, func(p, q proxy) int {
+		if p.box.Min.X != q.box.Min.X {
+			if p.box.Min.X < q.box.Min.X {
+				return -1
+			}
+			return 1
+		}
+		return int(p.body.id) - int(q.body.id)
+	})
+	var pairs []pair
+	active := make([]proxy, 0, len(proxies))
+	for _, p := range proxies {
+		// drop boxes that end before this one starts, as they cannot meet any later box either
+		open := active[:0]
+		for _, q := range active {
+			if q.box.Max.X >= p.box.Min.X {
+				open = append(open, q)
+			}
+		}
+		active = open
+		for _, q := range active {
+			if !q.box.Overlaps(p.box) {
+				continue
+			}
+			if candidate := newPair(q.body, p.body); accept(candidate.a, candidate.b) {
+				pairs = append(pairs, candidate)
+			}
+		}
+		active = append(active, p)
+	}
+	slices.SortFunc(pairs, func(p, q pair) int {
+		switch {
+		case p.key() < q.key():
+			return -1
+		case p.key() > q.key():
+			return 1
+		}
+		return 0
+	})
+	return pairs
+}
@@ -0,0 +1,39 @@
+package physics
+
+import (
+	"collision/circle"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAABBOverlaps - test that touching boxes overlap and separated boxes do not
+func TestAABBOverlaps(t *testing.T) {
+	a := AABB{Min: Vec2{X: 0, Y: 0}, Max: Vec2{X: 2, Y: 2}}
+	assert.True(t, a.Overlaps(AABB{Min: Vec2{X: 1, Y: 1}, Max: Vec2{X: 3, Y: 3}}))
+	assert.True(t, a.Overlaps(AABB{Min: Vec2{X: 2, Y: 0}, Max: Vec2{X: 3, Y: 1}}), "expect touching boxes to overlap")
+	assert.False(t, a.Overlaps(AABB{Min: Vec2{X: 0, Y: 3}, Max: Vec2{X: 1, Y: 4}}), "expect boxes overlapping in x only not to overlap")
+}
+
+// TestSweepAndPrune - test that pairs are found whatever order bodies are given in, and static pairs skipped
+func TestSweepAndPrune(t *testing.T) {
+	ground := NewBody(StaticBody, box(t, -10, -1, 10, 0), 1)
+	wall := NewBody(StaticBody, box(t, 9, 0, 10, 10), 1)
+	left := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(-5, 0.5, 1)), 1)
+	middle := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(-3.5, 0.5, 1)), 1)
+	far := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 5, 1)), 1)
+	for i, b := range []*Body{ground, wall, left, middle, far} {
+		b.id = BodyID(i + 1)
+	}
+
+	keys := func(pairs []pair) [][2]BodyID {
+		ids := make([][2]BodyID, len(pairs))
+		for i, p := range pairs {
+			ids[i] = [2]BodyID{p.a.id, p.b.id}
+		}
+		return ids
+	}
+	expected := [][2]BodyID{{1, 3}, {1, 4}, {3, 4}}
+	assert.Equal(t, expected, keys(sweepAndPrune([]*Body{ground, wall, left, middle, far}, NewWorld(Vec2{}).shouldCollide)))
+	assert.Equal(t, expected, keys(sweepAndPrune([]*Body{far, middle, left, wall, ground}, NewWorld(Vec2{}).shouldCollide)), "expect order of bodies not to matter")
+}
@@ -0,0 +1,171 @@
+package physics
+
+import (
+	"math"
+)
+
+// Solver tuning
+const (
+	linearSlop           = 0.005 // overlap left uncorrected, so resting contacts persist from step to step
+	baumgarte            = 0.2   // fraction of overlap beyond the slop corrected in each step
+	restitutionThreshold = 1     // approach speed below which contacts do not bounce, so resting bodies settle
+)
+
+// Contact - two bodies touching at the end of the last step
+type Contact struct {
+	BodyA  *Body          // body with the lower ID
+	BodyB  *Body          // body with the higher ID
+	Normal Vec2           // unit normal pointing from BodyA to BodyB
+	Points []ContactPoint // one or two points where they touch
+}
+
+// ContactPoint - point where two bodies touch, and the impulses applied there in the last step
+type ContactPoint struct {
+	Position       Vec2    // world point midway between the two surfaces
+	Separation     float64 // distance between the surfaces along the normal, negative when they overlap
+	NormalImpulse  float64 // impulse pushing the bodies apart along the normal
+	TangentImpulse float64 // friction impulse along the surfaces
+}
+
+// contactPoint - constraint at one point of a contact
+type contactPoint struct {
+	manifoldPoint
+	rA, rB         Vec2    // offsets of point from centres of mass
+	normalImpulse  float64 // impulse accumulated along normal, kept between steps for warm starting
+	tangentImpulse float64 // impulse accumulated along tangent, kept between steps for warm starting
+	normalMass     float64 // effective mass along normal
+	tangentMass    float64 // effective mass along tangent
+	bias           float64 // target separating speed, from restitution or overlap correction
+}
+
+// contact - non-penetration and friction constraints between two touching bodies
+type contact struct {
+	a, b        *Body
+	normal      Vec2
+	points      []contactPoint
+	friction    float64
+	restitution float64
+	persisted   bool // the bodies were also touching in the previous step
+}
+
+// newContact - contact for a manifold between the bodies of a pair
+func newContact(p pair, m manifold) *contact {
+	c := &contact{
+		a:           p.a,
+		b:           p.b,
+		normal:      m.normal,
+		points:      make([]contactPoint, len(m.points)),
+		friction:    math.Sqrt(p.a.Friction * p.b.Friction),
+		restitution: math.Max(p.a.Restitution, p.b.Restitution),
+	}
+	for i, mp := range m.points {
+		c.points[i].manifoldPoint = mp
+	}
+	return c
+}
+
+// key - identifier of the pair of bodies in contact
+func (c *contact) key() pairKey {
+	return pair{a: c.a, b: c.b}.key()
+}
+
+// tangent - unit vector along the surfaces, a right angle clockwise of the normal
+func (c *contact) tangent() Vec2 {
+	return Vec2{X: c.normal.Y, Y: -c.normal.X}
+}
+
+// inherit - carry accumulated impulses over from the same contact in the previous step, matching points by the
+// features that produced them
+func (c *contact) inherit(previous *contact) {
+	c.persisted = true
+	for i := range c.points {
+		for _, old := range previous.points {
+			if old.id == c.points[i].id {
+				c.points[i].normalImpulse = old.normalImpulse
+				c.points[i].tangentImpulse = old.tangentImpulse
+				break
+			}
+		}
+	}
+}
+
+// preStep - compute effective masses and bias speeds, and apply the impulses carried over from the previous
+// step if warm starting
+func (c *contact) preStep(invDt float64, warmStart bool) {
+	a, b := c.a, c.b
+	tangent := c.tangent()
+	for i := range c.points {
+		cp := &c.points[i]
+		cp.rA, cp.rB = cp.position.Sub(a.Position), cp.position.Sub(b.Position)
+		cp.normalMass = effectiveMass(a, b, cp.rA, cp.rB, c.normal)
+		cp.tangentMass = effectiveMass(a, b, cp.rA, cp.rB, tangent)
+		cp.bias = 0
+		if approach := c.relativeVelocity(cp).Dot(c.normal); approach < -restitutionThreshold {
+			cp.bias = -c.restitution * approach
+		}
+		cp.bias = math.Max(cp.bias, -baumgarte*invDt*math.Min(0, cp.separation+linearSlop))
+		if !warmStart {
+			cp.normalImpulse, cp.tangentImpulse = 0, 0
+			continue
+		}
+		impulse := c.normal.Scale(cp.normalImpulse).Add(tangent.Scale(cp.tangentImpulse))
+		a.applyImpulse(impulse.Neg(), cp.rA)
+		b.applyImpulse(impulse, cp.rB)
+	}
+}
+
+// solve - one pass of sequential impulses over the points of the contact. Accumulated impulses are clamped,
+// rather than each increment, so that later passes can undo the overshoot of earlier ones.
+func (c *contact) solve() {
+	a, b := c.a, c.b
+	tangent := c.tangent()
+	for i := range c.points {
+		cp := &c.points[i]
+		// friction is bounded by the normal impulse, which changes below, so use the latest value
+		limit := c.friction * cp.normalImpulse
+		lambda := -cp.tangentMass * c.relativeVelocity(cp).Dot(tangent)
+		accumulated := math.Max(-limit, math.Min(cp.tangentImpulse+lambda, limit))
+		lambda, cp.tangentImpulse = accumulated-cp.tangentImpulse, accumulated
+		impulse := tangent.Scale(lambda)
+		a.applyImpulse(impulse.Neg(), cp.rA)
+		b.applyImpulse(impulse, cp.rB)
+
+		lambda = -cp.normalMass * (c.relativeVelocity(cp).Dot(c.normal) - cp.bias)
+		accumulated = math.Max(cp.normalImpulse+lambda, 0)
+		lambda, cp.normalImpulse = accumulated-cp.normalImpulse, accumulated
+		impulse = c.normal.Scale(lambda)
+		a.applyImpulse(impulse.Neg(), cp.rA)
+		b.applyImpulse(impulse, cp.rB)
+	}
+}
+
+// relativeVelocity - velocity of the point on b relative to the point on a
+func (c *contact) relativeVelocity(cp *contactPoint) Vec2 {
+	vA := c.a.Velocity.Add(crossScalar(c.a.AngularVelocity, cp.rA))
+	vB := c.b.Velocity.Add(crossScalar(c.b.AngularVelocity, cp.rB))
+	return vB.Sub(vA)
+}
+
+// public - exported view of contact
+func (c *contact) public() Contact {
+	points := make([]ContactPoint, len(c.points))
+	for i, cp := range c.points {
+		points[i] = ContactPoint{
+			Position:       cp.position,
+			Separation:     cp.separation,
+			NormalImpulse:  cp.normalImpulse,
+			TangentImpulse: cp.tangentImpulse,
+		}
+	}
+	return Contact{BodyA: c.a, BodyB: c.b, Normal: c.normal, Points: points}
+}
+
+// effectiveMass - mass felt by an impulse along direction at offsets rA and rB, zero if neither body can move
+func effectiveMass(a, b *Body, rA, rB, direction Vec2) float64 {
+	crossA, crossB := rA.Cross(direction), rB.Cross(direction)
+	k := a.invMass + b.invMass + a.invInertia*crossA*crossA + b.invInertia*crossB*crossB
+	if k <= 0 {
+		return 0
+	}
+	return 1 / k
+}
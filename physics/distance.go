@@ -0,0 +1,95 @@
+package physics
+
+import "math"
+
+// DistanceJoint - joint holding anchors on two bodies at a fixed distance apart, like a massless rod pinned to
+// each body
+type DistanceJoint struct {
+	jointBase
+	Length  float64 // distance held between the anchors
+	axis    Vec2    // unit vector from anchor on the first body to anchor on the second
+	mass    float64 // effective mass along axis
+	bias    float64 // speed correcting the error in length
+	impulse float64 // impulse accumulated along axis, kept between steps for warm starting
+}
+
+// NewDistanceJoint - returns a pointer to a joint holding anchors given in world coordinates at their current
+// distance apart
+func NewDistanceJoint(a, b *Body, anchorA, anchorB Vec2) *DistanceJoint {
+	return &DistanceJoint{jointBase: newJointBase(a, b, anchorA, anchorB), Length: anchorB.Sub(anchorA).Length()}
+}
+
+// preStep - compute effective mass and bias speed, and apply the impulse carried over from the previous step if
+// warm starting
+func (j *DistanceJoint) preStep(invDt float64, warmStart bool) {
+	j.anchors()
+	separation := j.separation()
+	j.axis = separation.Unit()
+	j.mass = effectiveMass(j.a, j.b, j.rA, j.rB, j.axis)
+	j.bias = baumgarte * invDt * (separation.Length() - j.Length)
+	if !warmStart {
+		j.impulse = 0
+	}
+	j.applyLinear(j.axis.Scale(j.impulse))
+}
+
+// solve - one pass of sequential impulses along the axis
+func (j *DistanceJoint) solve() {
+	lambda := -j.mass * (j.relativeVelocity().Dot(j.axis) + j.bias)
+	j.impulse += lambda
+	j.applyLinear(j.axis.Scale(lambda))
+}
+
+// impulses - impulse applied to the second body in the current step
+func (j *DistanceJoint) impulses() (Vec2, float64) {
+	return j.axis.Scale(j.impulse), 0
+}
+
+// RopeJoint - joint keeping anchors on two bodies no further apart than a maximum length, like a rope tied to
+// each body. The rope goes slack when they are closer and pulls but never pushes when taut.
+type RopeJoint struct {
+	jointBase
+	MaxLength float64 // furthest distance allowed between the anchors
+	axis      Vec2    // unit vector from anchor on the first body to anchor on the second
+	mass      float64 // effective mass along axis
+	bias      float64 // speed correcting stretch beyond the maximum length, or allowed slack closed in one step
+	impulse   float64 // impulse accumulated along axis, never positive, kept between steps for warm starting
+}
+
+// NewRopeJoint - returns a pointer to a joint keeping anchors given in world coordinates no further apart than
+// maxLength. If maxLength is provided as a negative, then abs value is assigned.
+func NewRopeJoint(a, b *Body, anchorA, anchorB Vec2, maxLength float64) *RopeJoint {
+	return &RopeJoint{jointBase: newJointBase(a, b, anchorA, anchorB), MaxLength: math.Abs(maxLength)}
+}
+
+// Taut - boolean indicating whether the rope was pulling on the bodies in the last step
+func (j *RopeJoint) Taut() bool {
+	return j.impulse < 0
+}
+
+// preStep - compute effective mass and bias speed, and apply the impulse carried over from the previous step if
+// warm starting
+func (j *RopeJoint) preStep(invDt float64, warmStart bool) {
+	j.anchors()
+	separation := j.separation()
+	j.axis = separation.Unit()
+	j.mass = effectiveMass(j.a, j.b, j.rA, j.rB, j.axis)
+	j.bias = -limitBias(j.MaxLength-separation.Length(), invDt)
+	if !warmStart {
+		j.impulse = 0
+	}
+	j.applyLinear(j.axis.Scale(j.impulse))
+}
+
+// solve - one pass of sequential impulses along the axis, clamped so the rope only pulls
+func (j *RopeJoint) solve() {
+	lambda := -j.mass * (j.relativeVelocity().Dot(j.axis) + j.bias)
+	accumulated := math.Min(j.impulse+lambda, 0)
+	lambda, j.impulse = accumulated-j.impulse, accumulated
+	j.applyLinear(j.axis.Scale(lambda))
+}
+
+// impulses - impulse applied to the second body in the current step
+func (j *RopeJoint) impulses() (Vec2, float64) {
+	return j.axis.Scale(j.impulse), 0
+}
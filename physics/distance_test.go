@@ -0,0 +1,56 @@
+package physics
+
+import (
+	"collision/circle"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDistanceJointPendulum - test that a pendulum on a rod swings at its length from the pivot
+func TestDistanceJointPendulum(t *testing.T) {
+	w := NewWorld(Vec2{X: 0, Y: -10})
+	pivot := NewBody(StaticBody, NewCircleShape(circle.NewCircle(0, 0, 0.1)), 1)
+	assert.Nil(t, w.AddBody(pivot))
+	bob := newBall(t, w, 3, 0, 0.25)
+	j := NewDistanceJoint(pivot, bob, Vec2{}, bob.Position)
+	assert.InDelta(t, 3, j.Length, 1e-6)
+	assert.Nil(t, w.AddJoint(j))
+
+	lowest := 0.0
+	for i := 0; i < 120; i++ {
+		run(w, 1)
+		assert.InDelta(t, 3, bob.Position.Length(), 0.02)
+		lowest = math.Min(lowest, bob.Position.Y)
+	}
+	assert.InDelta(t, -3, lowest, 0.05, "expect pendulum to swing through the bottom")
+}
+
+// TestRopeJoint - test that a rope lets a body fall freely until taut, then holds it at its length
+func TestRopeJoint(t *testing.T) {
+	w := NewWorld(Vec2{X: 0, Y: -10})
+	pivot := NewBody(StaticBody, NewCircleShape(circle.NewCircle(0, 0, 0.1)), 1)
+	assert.Nil(t, w.AddBody(pivot))
+	weight := newBall(t, w, 0, -1, 0.25)
+	j := NewRopeJoint(pivot, weight, Vec2{}, weight.Position, -2)
+	assert.Equal(t, 2.0, j.MaxLength)
+	assert.Nil(t, w.AddJoint(j))
+
+	run(w, 10)
+	assert.False(t, j.Taut())
+	assert.Equal(t, Vec2{}, j.ReactionForce())
+	assert.InDelta(t, -10*10*w.TimeStep, weight.Velocity.Y, 1e-9, "expect slack rope to let weight fall freely")
+
+	run(w, 120)
+	assert.True(t, j.Taut())
+	assert.InDelta(t, -2, weight.Position.Y, 0.01)
+	assert.InDelta(t, weight.Mass()*10, j.ReactionForce().Y, 1e-3)
+
+	// pushed back towards the pivot, the rope goes slack rather than pushing back
+	weight.Velocity = Vec2{X: 0, Y: 3}
+	weight.SetAwake(true)
+	run(w, 1)
+	assert.False(t, j.Taut())
+	assert.Greater(t, weight.Velocity.Y, 2.5)
+}
@@ -0,0 +1,38 @@
+package physics
+
+// ContactCallback - function called with a contact between two bodies. Callbacks run once a step has finished,
+// so they may add, remove or change bodies.
+type ContactCallback func(Contact)
+
+// events - callbacks waiting to be called, in order, each with its argument already bound
+type events []func()
+
+// fire - call each callback in turn
+func (e events) fire() {
+	for _, call := range e {
+		call()
+	}
+}
+
+// contactEvents - OnEnd events for ended contacts, followed by OnBegin or OnStay events for current contacts.
+// Contacts are copied when the events are made, so callbacks see them as they were at the end of the step.
+func (w *World) contactEvents(ended, current []*contact) events {
+	var e events
+	add := func(callback ContactCallback, c *contact) {
+		if callback != nil {
+			contact := c.public()
+			e = append(e, func() { callback(contact) })
+		}
+	}
+	for _, c := range ended {
+		add(w.OnEnd, c)
+	}
+	for _, c := range current {
+		if c.persisted {
+			add(w.OnStay, c)
+		} else {
+			add(w.OnBegin, c)
+		}
+	}
+	return e
+}
@@ -0,0 +1,107 @@
+package physics
+
+import (
+	"collision/circle"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// eventLog - record of contact callbacks as strings of the callback name and body IDs
+type eventLog []string
+
+// hook - set world's callbacks to append to the log
+func (l *eventLog) hook(w *World) {
+	record := func(name string) ContactCallback {
+		return func(c Contact) {
+			*l = append(*l, name+" "+string(rune('0'+c.BodyA.ID()))+string(rune('0'+c.BodyB.ID())))
+		}
+	}
+	w.OnBegin, w.OnStay, w.OnEnd = record("begin"), record("stay"), record("end")
+}
+
+// TestContactCallbacks - test that a ball bouncing off the ground begins, stays and ends its contact once each
+// time it touches
+func TestContactCallbacks(t *testing.T) {
+	w, _ := newTestWorld(t)
+	var log eventLog
+	log.hook(w)
+	var begun Contact
+	onBegin := w.OnBegin
+	w.OnBegin = func(c Contact) {
+		begun = c
+		onBegin(c)
+	}
+
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0.5, 0.5)), 1)
+	ball.Velocity = Vec2{X: 0, Y: -1}
+	assert.Nil(t, w.AddBody(ball))
+	run(w, 3)
+	assert.Equal(t, eventLog{"begin 12", "stay 12", "stay 12"}, log)
+	assert.Equal(t, ball, begun.BodyB)
+	assert.Equal(t, Vec2{X: 0, Y: 1}, begun.Normal)
+	assert.Len(t, begun.Points, 1)
+	assert.Greater(t, begun.Points[0].NormalImpulse, 0.0, "expect contact data from after the solver ran")
+
+	// knocked upwards, the ball leaves the ground then lands again
+	log = nil
+	ball.Velocity = Vec2{X: 0, Y: 3}
+	run(w, 60)
+	counts := map[string]int{}
+	for _, e := range log {
+		counts[e]++
+	}
+	assert.Equal(t, 1, counts["end 12"])
+	assert.Equal(t, 1, counts["begin 12"])
+	assert.Less(t, slices.Index(log, "end 12"), slices.Index(log, "begin 12"))
+	assert.Equal(t, "stay 12", log[len(log)-1])
+}
+
+// TestContactCallbacksOrder - test that ends come before begins and stays, each in order of body IDs
+func TestContactCallbacksOrder(t *testing.T) {
+	w := NewWorld(Vec2{})
+	var log eventLog
+	log.hook(w)
+	a := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0, 1)), 1)
+	b := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(1.5, 0, 1)), 1)
+	c := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(5, 0, 1)), 1)
+	d := NewBody(StaticBody, NewCircleShape(circle.NewCircle(6.5, 0, 1)), 1)
+	for _, body := range []*Body{a, b, c, d} {
+		assert.Nil(t, w.AddBody(body))
+	}
+	run(w, 1)
+	assert.Equal(t, eventLog{"begin 12", "begin 34"}, log)
+
+	// pull the first pair apart and bring the second body against the third
+	log = nil
+	a.Position, b.Position = Vec2{X: -10, Y: 0}, Vec2{X: 3.5, Y: 0}
+	a.Velocity, b.Velocity = Vec2{}, Vec2{}
+	run(w, 1)
+	assert.Equal(t, eventLog{"end 12", "begin 23", "stay 34"}, log)
+}
+
+// TestRemoveBodyEndsContacts - test that removing a body ends its contacts, and callbacks may remove bodies
+func TestRemoveBodyEndsContacts(t *testing.T) {
+	w, ground := newTestWorld(t)
+	var log eventLog
+	log.hook(w)
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0.5, 0.5)), 1)
+	assert.Nil(t, w.AddBody(ball))
+	run(w, 1)
+	assert.Nil(t, w.RemoveBody(ball))
+	assert.Equal(t, eventLog{"begin 12", "end 12"}, log)
+
+	// a ball that disappears on touching the ground
+	log = nil
+	popped := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0.5, 0.5)), 1)
+	assert.Nil(t, w.AddBody(popped))
+	onBegin := w.OnBegin
+	w.OnBegin = func(c Contact) {
+		onBegin(c)
+		assert.Nil(t, w.RemoveBody(c.BodyB))
+	}
+	run(w, 2)
+	assert.Equal(t, eventLog{"begin 13", "end 13"}, log)
+	assert.Equal(t, []*Body{ground}, w.Bodies())
+}
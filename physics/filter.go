@@ -0,0 +1,44 @@
+package physics
+
+// Filter - collision filtering data of a body. Two bodies collide if each one's category is in the other's mask,
+// unless they share a group: a shared positive group always collides and a shared negative group never does.
+type Filter struct {
+	Category uint32 // bits for the categories the body belongs to, such as players or pickups
+	Mask     uint32 // bits for the categories the body collides with
+	Group    int32  // bodies with the same non-zero group override their categories and masks
+}
+
+// DefaultFilter - filter of new bodies, in the first category and colliding with every category
+var DefaultFilter = Filter{Category: 1, Mask: 0xFFFFFFFF}
+
+// CollisionPredicate - function deciding whether two bodies may collide, given bodies in order of ID
+type CollisionPredicate func(a, b *Body) bool
+
+// ShouldCollide - boolean indicating whether bodies with filters f and g collide
+func (f Filter) ShouldCollide(g Filter) bool {
+	if f.Group != 0 && f.Group == g.Group {
+		return f.Group > 0
+	}
+	return f.Category&g.Mask != 0 && g.Category&f.Mask != 0
+}
+
+// shouldCollide - boolean indicating whether a pair of bodies may collide, checked before their shapes are
+// compared. Only dynamic bodies respond to contacts, sensors only notice bodies that can move, other than
+// sensors, and jointed bodies only collide if their joint allows it. Then the bodies' filters and the world's
+// predicate must both allow it.
+func (w *World) shouldCollide(a, b *Body) bool {
+	switch {
+	case a.Sensor || b.Sensor:
+		if a.Sensor == b.Sensor || a.Sensor && b.Type == StaticBody || b.Sensor && a.Type == StaticBody {
+			return false
+		}
+	case a.Type != DynamicBody && b.Type != DynamicBody:
+		return false
+	case jointed(a, b):
+		return false
+	}
+	if !a.Filter.ShouldCollide(b.Filter) {
+		return false
+	}
+	return w.ShouldCollide == nil || w.ShouldCollide(a, b)
+}
@@ -0,0 +1,82 @@
+package physics
+
+import (
+	"collision/circle"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFilterShouldCollide - test categories, masks and groups
+func TestFilterShouldCollide(t *testing.T) {
+	const (
+		players uint32 = 1 << iota
+		pickups
+		scenery
+	)
+	player := Filter{Category: players, Mask: pickups | scenery}
+	pickup := Filter{Category: pickups, Mask: players}
+	wall := Filter{Category: scenery, Mask: 0xFFFFFFFF}
+
+	tests := []struct {
+		name     string
+		f, g     Filter
+		expected bool
+	}{
+		{"default filters", DefaultFilter, DefaultFilter, true},
+		{"player and pickup", player, pickup, true},
+		{"pickup and wall", pickup, wall, false},
+		{"pickups", pickup, pickup, false},
+		{"players", player, player, false},
+		{"negative group", Filter{Category: 1, Mask: 1, Group: -2}, Filter{Category: 1, Mask: 1, Group: -2}, false},
+		{"positive group", Filter{Category: 1, Group: 3}, Filter{Category: 2, Group: 3}, true},
+		{"different groups", Filter{Category: 1, Mask: 1, Group: -2}, Filter{Category: 1, Mask: 1, Group: -3}, true},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.f.ShouldCollide(test.g), test.name)
+		assert.Equal(t, test.expected, test.g.ShouldCollide(test.f), test.name+" swapped")
+	}
+}
+
+// TestWorldFilter - test that filtered pairs never touch, and the predicate is only asked about pairs whose
+// filters and boxes allow them to collide
+func TestWorldFilter(t *testing.T) {
+	w := NewWorld(Vec2{})
+	owner := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0, 1)), 1)
+	bullet := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(1, 0, 0.25)), 1)
+	target := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(3, 0, 1)), 1)
+	ghost := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 1, 1)), 1)
+	ghost.Filter.Mask = 0
+	for _, b := range []*Body{owner, bullet, target, ghost} {
+		assert.Nil(t, w.AddBody(b))
+	}
+	// bullet overlaps its owner, but is fired through it
+	bullet.UserData = owner
+	var asked [][2]BodyID
+	w.ShouldCollide = func(a, b *Body) bool {
+		asked = append(asked, [2]BodyID{a.ID(), b.ID()})
+		return a.UserData != b && b.UserData != a
+	}
+	bullet.Velocity = Vec2{X: 60, Y: 0}
+	run(w, 1)
+	assert.Equal(t, [][2]BodyID{{1, 2}}, asked, "expect only overlapping pair passing filters")
+	assert.Empty(t, w.Contacts())
+	assert.Equal(t, Vec2{}, owner.Velocity)
+	assert.Equal(t, Vec2{}, ghost.Velocity, "expect ghost to pass through owner")
+
+	// the bullet reaches the target
+	asked = nil
+	run(w, 1)
+	assert.Contains(t, asked, [2]BodyID{2, 3})
+	contacts := w.Contacts()
+	assert.Len(t, contacts, 1)
+	assert.Equal(t, target, contacts[0].BodyB)
+
+	// bullet and target in the same negative group no longer collide, and their contact ends
+	ended := 0
+	w.OnEnd = func(Contact) { ended++ }
+	bullet.Filter.Group, target.Filter.Group = -1, -1
+	run(w, 1)
+	assert.Empty(t, w.Contacts())
+	assert.Equal(t, 1, ended)
+}
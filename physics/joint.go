@@ -0,0 +1,259 @@
+package physics
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+var (
+	ErrJointInWorld    = errors.New("joint already belongs to a world")
+	ErrJointNotInWorld = errors.New("joint does not belong to this world")
+)
+
+// Joint - constraint between two bodies, solved alongside contacts. Joints are made by the constructors in this
+// package and added to a world with AddJoint.
+type Joint interface {
+	// Bodies - bodies joined, the first of which is nil for a joint to a point in the world
+	Bodies() (a, b *Body)
+	// ReactionForce - force applied to the second body by the joint in the last step
+	ReactionForce() Vec2
+	// ReactionTorque - torque applied to the second body by the joint in the last step
+	ReactionTorque() float64
+	// Broken - boolean indicating whether the joint broke under a force or torque beyond its limits
+	Broken() bool
+	base() *jointBase
+	// preStep - compute effective masses and bias speeds, and apply the impulses carried over from the previous
+	// step if warm starting
+	preStep(invDt float64, warmStart bool)
+	// solve - one pass of sequential impulses
+	solve()
+	// impulses - linear and angular impulses applied to the second body in the current step
+	impulses() (Vec2, float64)
+}
+
+// JointBreakCallback - function called when a joint breaks. Callbacks run once a step has finished, so they may
+// add, remove or change bodies and joints.
+type JointBreakCallback func(Joint)
+
+// jointBase - bodies, anchors and breaking limits shared by every joint
+type jointBase struct {
+	a, b             *Body
+	localA, localB   Vec2    // anchors in body coordinates
+	rA, rB           Vec2    // anchors relative to centres of mass in world coordinates, set by preStep
+	CollideConnected bool    // let the joined bodies collide with each other
+	BreakForce       float64 // reaction force beyond which the joint breaks, zero for a joint that never breaks
+	BreakTorque      float64 // reaction torque beyond which the joint breaks, zero for a joint that never breaks
+	world            *World
+	broken           bool
+	force            Vec2
+	torque           float64
+}
+
+// newJointBase - joint base with anchors given in world coordinates
+func newJointBase(a, b *Body, anchorA, anchorB Vec2) jointBase {
+	return jointBase{a: a, b: b, localA: a.LocalPoint(anchorA), localB: b.LocalPoint(anchorB)}
+}
+
+// Bodies - bodies joined
+func (j *jointBase) Bodies() (a, b *Body) {
+	return j.a, j.b
+}
+
+// ReactionForce - force applied to the second body by the joint in the last step
+func (j *jointBase) ReactionForce() Vec2 {
+	return j.force
+}
+
+// ReactionTorque - torque applied to the second body by the joint in the last step
+func (j *jointBase) ReactionTorque() float64 {
+	return j.torque
+}
+
+// Broken - boolean indicating whether the joint broke
+func (j *jointBase) Broken() bool {
+	return j.broken
+}
+
+// base - shared parts of joint
+func (j *jointBase) base() *jointBase {
+	return j
+}
+
+// anchors - set anchor offsets from the bodies' current placement
+func (j *jointBase) anchors() {
+	j.rA = newRotation(j.a.Angle).apply(j.localA)
+	j.rB = newRotation(j.b.Angle).apply(j.localB)
+}
+
+// separation - vector from anchor on the first body to anchor on the second
+func (j *jointBase) separation() Vec2 {
+	return j.b.Position.Add(j.rB).Sub(j.a.Position.Add(j.rA))
+}
+
+// relativeVelocity - velocity of the anchor on the second body relative to the anchor on the first
+func (j *jointBase) relativeVelocity() Vec2 {
+	vA := j.a.Velocity.Add(crossScalar(j.a.AngularVelocity, j.rA))
+	vB := j.b.Velocity.Add(crossScalar(j.b.AngularVelocity, j.rB))
+	return vB.Sub(vA)
+}
+
+// applyLinear - apply an impulse at the anchors, pushing the second body and pulling the first
+func (j *jointBase) applyLinear(impulse Vec2) {
+	j.a.applyImpulse(impulse.Neg(), j.rA)
+	j.b.applyImpulse(impulse, j.rB)
+}
+
+// applyAngular - apply an angular impulse, turning the second body and the first the other way
+func (j *jointBase) applyAngular(impulse float64) {
+	j.a.AngularVelocity -= j.a.invInertia * impulse
+	j.b.AngularVelocity += j.b.invInertia * impulse
+}
+
+// applyAlong - apply an impulse along direction whose moment arms about the first and second bodies' centres
+// of mass are armA and armB, pushing the second body and pulling the first
+func (j *jointBase) applyAlong(direction Vec2, armA, armB, impulse float64) {
+	j.a.Velocity = j.a.Velocity.Sub(direction.Scale(j.a.invMass * impulse))
+	j.a.AngularVelocity -= j.a.invInertia * armA * impulse
+	j.b.Velocity = j.b.Velocity.Add(direction.Scale(j.b.invMass * impulse))
+	j.b.AngularVelocity += j.b.invInertia * armB * impulse
+}
+
+// angularMass - effective mass against relative rotation of the bodies, zero if neither can turn
+func (j *jointBase) angularMass() float64 {
+	if k := j.a.invInertia + j.b.invInertia; k > 0 {
+		return 1 / k
+	}
+	return 0
+}
+
+// pointMass - matrix of effective mass against relative movement of the anchors
+func (j *jointBase) pointMass() mat22 {
+	mA, mB, iA, iB := j.a.invMass, j.b.invMass, j.a.invInertia, j.b.invInertia
+	rA, rB := j.rA, j.rB
+	offDiagonal := -iA*rA.X*rA.Y - iB*rB.X*rB.Y
+	return mat22{
+		a: mA + mB + iA*rA.Y*rA.Y + iB*rB.Y*rB.Y, b: offDiagonal,
+		c: offDiagonal, d: mA + mB + iA*rA.X*rA.X + iB*rB.X*rB.X,
+	}
+}
+
+// pointConstraint - constraint holding the anchors of two bodies together
+type pointConstraint struct {
+	mass    mat22 // inverse of effective mass, solved against rather than inverted
+	bias    Vec2  // speed correcting the anchors' separation
+	impulse Vec2  // impulse accumulated on the second body, kept between steps for warm starting
+}
+
+// prepare - compute effective mass and bias speed for the anchors of the joint, and apply the impulse carried
+// over from the previous step if warm starting
+func (p *pointConstraint) prepare(j *jointBase, invDt float64, warmStart bool) {
+	p.mass = j.pointMass()
+	p.bias = j.separation().Scale(baumgarte * invDt)
+	if !warmStart {
+		p.impulse = Vec2{}
+	}
+	j.applyLinear(p.impulse)
+}
+
+// solve - one pass of sequential impulses on the anchors of the joint
+func (p *pointConstraint) solve(j *jointBase) {
+	lambda := p.mass.solve(j.relativeVelocity().Add(p.bias)).Neg()
+	p.impulse = p.impulse.Add(lambda)
+	j.applyLinear(lambda)
+}
+
+// limitBias - bias speed of a constraint keeping position error c at or above zero. While c is positive the
+// constraint is speculative, only acting to stop the error passing zero within the step.
+func limitBias(c, invDt float64) float64 {
+	if c > 0 {
+		return c * invDt
+	}
+	return baumgarte * invDt * c
+}
+
+// mat22 - two by two matrix with rows (a, b) and (c, d)
+type mat22 struct {
+	a, b, c, d float64
+}
+
+// solve - x such that the matrix times x is v, or the zero vector if the matrix is singular
+func (m mat22) solve(v Vec2) Vec2 {
+	det := m.a*m.d - m.b*m.c
+	if det == 0 {
+		return Vec2{}
+	}
+	return Vec2{X: (m.d*v.X - m.b*v.Y) / det, Y: (m.a*v.Y - m.c*v.X) / det}
+}
+
+// AddJoint - add a joint between bodies in the world. Returns ErrJointInWorld if the joint already belongs to a
+// world, or ErrBodyNotInWorld if either body does not belong to this world.
+func (w *World) AddJoint(j Joint) error {
+	base := j.base()
+	if base.world != nil || base.broken {
+		return ErrJointInWorld
+	}
+	if a, b := j.Bodies(); a != nil && a.world != w || b.world != w {
+		return ErrBodyNotInWorld
+	}
+	base.world = w
+	w.joints = append(w.joints, j)
+	base.a.joints = append(base.a.joints, j)
+	base.b.joints = append(base.b.joints, j)
+	return nil
+}
+
+// RemoveJoint - remove a joint from the world, waking the bodies it joined. Returns ErrJointNotInWorld if it
+// belongs to another world or none.
+func (w *World) RemoveJoint(j Joint) error {
+	base := j.base()
+	if base.world != w {
+		return ErrJointNotInWorld
+	}
+	isJ := func(other Joint) bool { return other == j }
+	w.joints = slices.DeleteFunc(w.joints, isJ)
+	base.a.joints = slices.DeleteFunc(base.a.joints, isJ)
+	base.b.joints = slices.DeleteFunc(base.b.joints, isJ)
+	base.a.SetAwake(true)
+	base.b.SetAwake(true)
+	base.world = nil
+	return nil
+}
+
+// Joints - joints in the world, in order of addition
+func (w *World) Joints() []Joint {
+	return append([]Joint{}, w.joints...)
+}
+
+// jointed - boolean indicating whether a joint between the bodies stops them colliding
+func jointed(a, b *Body) bool {
+	for _, j := range a.joints {
+		base := j.base()
+		if (base.a == b || base.b == b) && !base.CollideConnected {
+			return true
+		}
+	}
+	return false
+}
+
+// breakJoints - record the reaction of each joint over a step of dt, and remove those pushed beyond their
+// limits. Returns the events for the joints that broke.
+func (w *World) breakJoints(dt float64) events {
+	var e events
+	for _, j := range w.Joints() {
+		base := j.base()
+		impulse, angular := j.impulses()
+		base.force, base.torque = impulse.Scale(1/dt), angular/dt
+		if (base.BreakForce <= 0 || base.force.Length() <= base.BreakForce) &&
+			(base.BreakTorque <= 0 || math.Abs(base.torque) <= base.BreakTorque) {
+			continue
+		}
+		_ = w.RemoveJoint(j)
+		base.broken = true
+		if w.OnJointBreak != nil {
+			callback, broken := w.OnJointBreak, j
+			e = append(e, func() { callback(broken) })
+		}
+	}
+	return e
+}
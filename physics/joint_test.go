@@ -0,0 +1,102 @@
+package physics
+
+import (
+	"collision/circle"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBall - dynamic ball of unit density added to the world
+func newBall(t *testing.T, w *World, x, y, radius float32) *Body {
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(x, y, radius)), 1)
+	assert.Nil(t, w.AddBody(ball))
+	return ball
+}
+
+// TestWorldAddRemoveJoint - test that joints belong to one world at a time, between bodies of that world, and
+// go with the bodies they attach
+func TestWorldAddRemoveJoint(t *testing.T) {
+	w, ground := newTestWorld(t)
+	ball := newBall(t, w, 0, 5, 0.5)
+	j := NewDistanceJoint(ground, ball, Vec2{X: 0, Y: 8}, ball.Position)
+	assert.Nil(t, w.AddJoint(j))
+	assert.ErrorIs(t, w.AddJoint(j), ErrJointInWorld)
+	assert.Equal(t, []Joint{j}, w.Joints())
+
+	stray := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0, 1)), 1)
+	assert.ErrorIs(t, w.AddJoint(NewWeldJoint(ball, stray, Vec2{})), ErrBodyNotInWorld)
+	assert.ErrorIs(t, w.AddJoint(NewMouseJoint(stray, Vec2{}, 1)), ErrBodyNotInWorld)
+
+	assert.Nil(t, w.RemoveJoint(j))
+	assert.ErrorIs(t, w.RemoveJoint(j), ErrJointNotInWorld)
+	assert.Empty(t, w.Joints())
+
+	assert.Nil(t, w.AddJoint(j))
+	mouse := NewMouseJoint(ball, ball.Position, 100)
+	assert.Nil(t, w.AddJoint(mouse))
+	a, b := mouse.Bodies()
+	assert.Nil(t, a)
+	assert.Equal(t, ball, b)
+	assert.Nil(t, w.RemoveBody(ball))
+	assert.Empty(t, w.Joints(), "expect joints of removed body to go with it")
+}
+
+// TestJointedBodiesDoNotCollide - test that bodies joined together pass through each other unless the joint lets
+// them collide
+func TestJointedBodiesDoNotCollide(t *testing.T) {
+	for _, collide := range []bool{false, true} {
+		w := NewWorld(Vec2{})
+		left := newBall(t, w, 0, 0, 1)
+		right := newBall(t, w, 1.5, 0, 1)
+		j := NewRopeJoint(left, right, left.Position, right.Position, 5)
+		j.CollideConnected = collide
+		assert.Nil(t, w.AddJoint(j))
+		run(w, 1)
+		if collide {
+			assert.Len(t, w.Contacts(), 1)
+		} else {
+			assert.Empty(t, w.Contacts())
+		}
+	}
+}
+
+// TestJointBreaks - test that a joint holding more than its breaking force is removed and reported once the step
+// has finished
+func TestJointBreaks(t *testing.T) {
+	w := NewWorld(Vec2{X: 0, Y: -10})
+	anchor := NewBody(StaticBody, NewCircleShape(circle.NewCircle(0, 10, 0.1)), 1)
+	assert.Nil(t, w.AddBody(anchor))
+	weight := newBall(t, w, 0, 8, 0.5)
+	j := NewDistanceJoint(anchor, weight, anchor.Position, weight.Position)
+	weightForce := weight.Mass() * 10
+	j.BreakForce = 2 * weightForce
+	assert.Nil(t, w.AddJoint(j))
+
+	var broken []Joint
+	w.OnJointBreak = func(joint Joint) {
+		assert.Empty(t, w.Joints(), "expect joint removed before callback")
+		broken = append(broken, joint)
+	}
+	run(w, 30)
+	assert.Empty(t, broken)
+	assert.InDelta(t, weightForce, j.ReactionForce().Length(), 1e-6*weightForce)
+	assert.InDelta(t, 8, weight.Position.Y, 1e-3)
+
+	// pulling down with more than the breaking force snaps the joint and the weight falls
+	weight.ApplyForceToCentre(Vec2{X: 0, Y: -2 * weightForce})
+	run(w, 1)
+	assert.Equal(t, []Joint{j}, broken)
+	assert.True(t, j.Broken())
+	assert.Empty(t, w.Joints())
+	assert.ErrorIs(t, w.AddJoint(j), ErrJointInWorld, "expect broken joint not to be reused")
+	run(w, 30)
+	assert.Less(t, weight.Position.Y, 7.0)
+}
+
+// TestMat22Solve - test solving a two by two system
+func TestMat22Solve(t *testing.T) {
+	m := mat22{a: 2, b: 1, c: 1, d: 3}
+	assertVec(t, Vec2{X: 1, Y: 2}, m.solve(Vec2{X: 4, Y: 7}))
+	assert.Equal(t, Vec2{}, mat22{a: 1, b: 2, c: 2, d: 4}.solve(Vec2{X: 1, Y: 1}))
+}
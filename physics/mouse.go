@@ -0,0 +1,85 @@
+package physics
+
+import "math"
+
+// Defaults for new mouse joints
+const (
+	DefaultMouseFrequency    = 5   // oscillations per second of the spring pulling the body
+	DefaultMouseDampingRatio = 0.7 // damping of the spring, 1 for critical damping
+)
+
+// MouseJoint - joint pulling a point of a body towards a target with a damped spring of limited force, for
+// dragging a body about with a mouse or finger
+type MouseJoint struct {
+	jointBase
+	Target       Vec2    // world point the body's anchor is pulled towards
+	MaxForce     float64 // greatest force the spring applies
+	Frequency    float64 // oscillations per second of the spring
+	DampingRatio float64 // damping of the spring, 1 for critical damping
+	mass         mat22   // inverse of effective mass of the softened constraint
+	bias         Vec2    // speed from the spring's stretch
+	gamma        float64 // softness, from the spring's damping and stiffness
+	maxImpulse   float64 // greatest impulse the spring applies in a step
+	impulse      Vec2    // impulse accumulated on the body, kept between steps for warm starting
+}
+
+// NewMouseJoint - returns a pointer to a joint pulling the point of the body at target, given in world
+// coordinates, towards target with up to maxForce. If maxForce is provided as a negative, then abs value is
+// assigned.
+func NewMouseJoint(b *Body, target Vec2, maxForce float64) *MouseJoint {
+	// the target is held by a static body that belongs to no world, so the joint is solved like any other
+	ground := &Body{Type: StaticBody}
+	return &MouseJoint{
+		jointBase:    newJointBase(ground, b, Vec2{}, target),
+		Target:       target,
+		MaxForce:     math.Abs(maxForce),
+		Frequency:    DefaultMouseFrequency,
+		DampingRatio: DefaultMouseDampingRatio,
+	}
+}
+
+// Bodies - nil, as the joint pulls towards a point in the world, and the body pulled
+func (j *MouseJoint) Bodies() (a, b *Body) {
+	return nil, j.b
+}
+
+// preStep - compute effective mass and bias speed of the spring, and apply the impulse carried over from the
+// previous step if warm starting
+func (j *MouseJoint) preStep(invDt float64, warmStart bool) {
+	j.a.Position = j.Target
+	j.anchors()
+	dt := 1 / invDt
+	omega := 2 * math.Pi * j.Frequency
+	damping := 2 * j.b.mass * j.DampingRatio * omega
+	stiffness := j.b.mass * omega * omega
+	if soft := dt * (damping + dt*stiffness); soft > 0 {
+		j.gamma = 1 / soft
+	} else {
+		j.gamma = 0
+	}
+	j.mass = j.pointMass()
+	j.mass.a += j.gamma
+	j.mass.d += j.gamma
+	j.bias = j.separation().Scale(dt * stiffness * j.gamma)
+	j.maxImpulse = j.MaxForce * dt
+	if !warmStart {
+		j.impulse = Vec2{}
+	}
+	j.applyLinear(j.impulse)
+}
+
+// solve - one pass of sequential impulses on the body's anchor, clamped to the spring's greatest force
+func (j *MouseJoint) solve() {
+	lambda := j.mass.solve(j.relativeVelocity().Add(j.bias).Add(j.impulse.Scale(j.gamma))).Neg()
+	accumulated := j.impulse.Add(lambda)
+	if length := accumulated.Length(); length > j.maxImpulse {
+		accumulated = accumulated.Scale(j.maxImpulse / length)
+	}
+	lambda, j.impulse = accumulated.Sub(j.impulse), accumulated
+	j.applyLinear(lambda)
+}
+
+// impulses - impulse applied to the body in the current step
+func (j *MouseJoint) impulses() (Vec2, float64) {
+	return j.impulse, 0
+}
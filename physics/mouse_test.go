@@ -0,0 +1,28 @@
+package physics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMouseJointDrag - test that a mouse joint drags a body to its target, and no harder than its greatest force
+func TestMouseJointDrag(t *testing.T) {
+	w, _ := newTestWorld(t)
+	crate := NewBody(DynamicBody, box(t, -0.5, 0, 0.5, 1), 1)
+	assert.Nil(t, w.AddBody(crate))
+	grab := Vec2{X: 0, Y: 1}
+	j := NewMouseJoint(crate, grab, -1000)
+	assert.Equal(t, 1000.0, j.MaxForce)
+	assert.Nil(t, w.AddJoint(j))
+
+	j.Target = Vec2{X: 3, Y: 4}
+	run(w, 180)
+	assert.InDelta(t, 0, crate.WorldPoint(j.localB).Sub(j.Target).Length(), 0.05)
+
+	// too weak to lift it, the joint cannot raise the crate off the ground
+	j.MaxForce = 0.5 * crate.Mass() * 10
+	run(w, 120)
+	assert.InDelta(t, 0.5*crate.Mass()*10, j.ReactionForce().Length(), 1e-6)
+	assert.Less(t, crate.WorldPoint(j.localB).Y, 2.0)
+}
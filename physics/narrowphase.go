@@ -0,0 +1,253 @@
+package physics
+
+import (
+	"math"
+)
+
+// referenceTolerance - amount by which the second polygon's separation must exceed the first's before its
+// edge is used as the reference face, so that the choice does not flicker between steps
+const referenceTolerance = 0.1 * linearSlop
+
+// transform - placement of a shape in world coordinates
+type transform struct {
+	position Vec2
+	rot      rotation
+}
+
+// bodyTransform - placement of body's shape
+func bodyTransform(b *Body) transform {
+	return transform{position: b.Position, rot: newRotation(b.Angle)}
+}
+
+// apply - point in shape coordinates transformed to world coordinates
+func (t transform) apply(v Vec2) Vec2 {
+	return t.position.Add(t.rot.apply(v))
+}
+
+// toLocal - point in world coordinates transformed to shape coordinates
+func (t transform) toLocal(v Vec2) Vec2 {
+	return t.rot.inverse(v.Sub(t.position))
+}
+
+// manifoldPoint - point where two shapes touch
+type manifoldPoint struct {
+	position   Vec2    // world point midway between the two surfaces
+	separation float64 // distance between the surfaces along the normal, negative when they overlap
+	id         uint32  // features that meet at the point, the same from step to step while they stay in contact
+}
+
+// manifold - points where two shapes touch, with the normal shared by all of them
+type manifold struct {
+	normal Vec2 // unit normal pointing from the first shape to the second
+	points []manifoldPoint
+}
+
+// feature - parts of each shape that produced a contact point. A polygon contact point is identified by the
+// reference face and the incident vertex it came from, even once it has been clipped to a side of the reference
+// face, so that bodies whose edges line up do not lose their warm start as the clip flickers on and off.
+type feature struct {
+	reference uint8 // edge of the polygon whose face the point lies against, which NewPolygonShape keeps below 256
+	incident  uint8 // vertex of the other polygon the point came from, likewise
+	flip      bool  // the reference face belongs to the second shape
+}
+
+// id - feature packed into an integer for comparison between steps
+func (f feature) id() uint32 {
+	id := uint32(f.reference) | uint32(f.incident)<<8
+	if f.flip {
+		id |= 1 << 16
+	}
+	return id
+}
+
+// collide - manifold of two placed shapes, and boolean indicating whether they touch
+func collide(a Shape, ta transform, b Shape, tb transform) (manifold, bool) {
+	switch {
+	case a.kind == ShapeCircle && b.kind == ShapeCircle:
+		return collideCircles(a, ta, b, tb)
+	case a.kind == ShapePolygon && b.kind == ShapeCircle:
+		return collidePolygonCircle(a, ta, b, tb)
+	case a.kind == ShapeCircle && b.kind == ShapePolygon:
+		m, ok := collidePolygonCircle(b, tb, a, ta)
+		m.normal = m.normal.Neg()
+		return m, ok
+	}
+	return collidePolygons(a, ta, b, tb)
+}
+
+// collideCircles - manifold of two disks, with a single point on the line joining their centres
+func collideCircles(a Shape, ta transform, b Shape, tb transform) (manifold, bool) {
+	centreA, centreB := ta.apply(a.centre), tb.apply(b.centre)
+	between := centreB.Sub(centreA)
+	distance := between.Length()
+	if distance > a.radius+b.radius {
+		return manifold{}, false
+	}
+	// concentric disks have no preferred direction, so push them apart vertically
+	normal := Vec2{X: 0, Y: 1}
+	if distance > 0 {
+		normal = between.Scale(1 / distance)
+	}
+	separation := distance - a.radius - b.radius
+	return manifold{
+		normal: normal,
+		points: []manifoldPoint{{position: centreA.Add(normal.Scale(a.radius + separation/2)), separation: separation}},
+	}, true
+}
+
+// collidePolygonCircle - manifold of a convex polygon and a disk, with a single point. The edge of the polygon
+// the centre of the disk is furthest outside is found first, then the centre is tested against the Voronoi
+// regions of that edge and its two vertices.
+func collidePolygonCircle(p Shape, tp transform, c Shape, tc transform) (manifold, bool) {
+	centre := tp.toLocal(tc.apply(c.centre))
+	radius := c.radius
+	best, edge := math.Inf(-1), 0
+	for i, v := range p.vertices {
+		s := p.normals[i].Dot(centre.Sub(v))
+		if s > radius {
+			return manifold{}, false
+		}
+		if s > best {
+			best, edge = s, i
+		}
+	}
+	v1, v2 := p.vertices[edge], p.vertices[(edge+1)%len(p.vertices)]
+	normal, surface, separation := p.normals[edge], centre.Sub(p.normals[edge].Scale(best)), best-radius
+	if best > 0 {
+		// centre is outside the polygon, so may be nearer a vertex than the face
+		if centre.Sub(v1).Dot(v2.Sub(v1)) <= 0 {
+			normal, surface, separation = vertexRegion(centre, v1, radius)
+		} else if centre.Sub(v2).Dot(v1.Sub(v2)) <= 0 {
+			normal, surface, separation = vertexRegion(centre, v2, radius)
+		}
+		if separation > 0 {
+			return manifold{}, false
+		}
+	}
+	worldNormal := tp.rot.apply(normal)
+	return manifold{
+		normal: worldNormal,
+		points: []manifoldPoint{{position: tp.apply(surface).Add(worldNormal.Scale(separation / 2)), separation: separation}},
+	}, true
+}
+
+// vertexRegion - normal, surface point and separation of a disk nearest a polygon vertex
+func vertexRegion(centre, vertex Vec2, radius float64) (normal, surface Vec2, separation float64) {
+	offset := centre.Sub(vertex)
+	return offset.Unit(), vertex, offset.Length() - radius
+}
+
+// clipVertex - end of incident edge while it is clipped to the sides of the reference face
+type clipVertex struct {
+	v  Vec2
+	id feature
+}
+
+// collidePolygons - manifold of two convex polygons, with up to two points. The separating axis test finds the
+// face of either polygon along which they are furthest apart; if they overlap along every face, the face with
+// the least overlap is the reference face, and the edge of the other polygon most opposed to it is clipped to
+// the sides of the reference face.
+func collidePolygons(a Shape, ta transform, b Shape, tb transform) (manifold, bool) {
+	verticesA, normalsA := worldPolygon(a, ta)
+	verticesB, normalsB := worldPolygon(b, tb)
+	edgeA, separationA := maxSeparation(verticesA, normalsA, verticesB)
+	if separationA > 0 {
+		return manifold{}, false
+	}
+	edgeB, separationB := maxSeparation(verticesB, normalsB, verticesA)
+	if separationB > 0 {
+		return manifold{}, false
+	}
+	reference, referenceNormals, incident, incidentNormals, referenceEdge, flip := verticesA, normalsA, verticesB, normalsB, edgeA, false
+	if separationB > separationA+referenceTolerance {
+		reference, referenceNormals, incident, incidentNormals, referenceEdge, flip = verticesB, normalsB, verticesA, normalsA, edgeB, true
+	}
+	normal := referenceNormals[referenceEdge]
+	incidentEdge, least := 0, math.Inf(1)
+	for i, n := range incidentNormals {
+		if d := normal.Dot(n); d < least {
+			incidentEdge, least = i, d
+		}
+	}
+	next := (incidentEdge + 1) % len(incident)
+	clip := []clipVertex{
+		{v: incident[incidentEdge], id: feature{reference: uint8(referenceEdge), incident: uint8(incidentEdge), flip: flip}},
+		{v: incident[next], id: feature{reference: uint8(referenceEdge), incident: uint8(next), flip: flip}},
+	}
+	referenceNext := (referenceEdge + 1) % len(reference)
+	v1, v2 := reference[referenceEdge], reference[referenceNext]
+	tangent := v2.Sub(v1).Unit()
+	clip = clipSegment(clip, tangent.Neg(), -tangent.Dot(v1))
+	if len(clip) < 2 {
+		return manifold{}, false
+	}
+	clip = clipSegment(clip, tangent, tangent.Dot(v2))
+	if len(clip) < 2 {
+		return manifold{}, false
+	}
+	frontOffset := normal.Dot(v1)
+	m := manifold{normal: normal}
+	if flip {
+		m.normal = normal.Neg()
+	}
+	for _, cv := range clip {
+		separation := normal.Dot(cv.v) - frontOffset
+		if separation > 0 {
+			continue
+		}
+		m.points = append(m.points, manifoldPoint{
+			position:   cv.v.Sub(normal.Scale(separation / 2)),
+			separation: separation,
+			id:         cv.id.id(),
+		})
+	}
+	return m, len(m.points) > 0
+}
+
+// worldPolygon - vertices and edge normals of polygon shape in world coordinates
+func worldPolygon(s Shape, t transform) (vertices, normals []Vec2) {
+	vertices, normals = make([]Vec2, len(s.vertices)), make([]Vec2, len(s.normals))
+	for i := range s.vertices {
+		vertices[i] = t.apply(s.vertices[i])
+		normals[i] = t.rot.apply(s.normals[i])
+	}
+	return vertices, normals
+}
+
+// maxSeparation - edge of first polygon along whose normal the second polygon lies furthest out, and that
+// distance, which is negative if they overlap along every edge normal of the first
+func maxSeparation(vertices, normals, other []Vec2) (edge int, separation float64) {
+	separation = math.Inf(-1)
+	for i, n := range normals {
+		least := math.Inf(1)
+		for _, v := range other {
+			least = math.Min(least, n.Dot(v.Sub(vertices[i])))
+		}
+		if least > separation {
+			edge, separation = i, least
+		}
+	}
+	return edge, separation
+}
+
+// clipSegment - part of segment behind the line where normal.v equals offset. A new end made by the clip keeps
+// the feature of the end it replaces.
+func clipSegment(in []clipVertex, normal Vec2, offset float64) []clipVertex {
+	out := make([]clipVertex, 0, 2)
+	d0, d1 := normal.Dot(in[0].v)-offset, normal.Dot(in[1].v)-offset
+	if d0 <= 0 {
+		out = append(out, in[0])
+	}
+	if d1 <= 0 {
+		out = append(out, in[1])
+	}
+	if d0*d1 < 0 {
+		t := d0 / (d0 - d1)
+		replaced := in[0].id
+		if d0 <= 0 {
+			replaced = in[1].id
+		}
+		out = append(out, clipVertex{v: in[0].v.Add(in[1].v.Sub(in[0].v).Scale(t)), id: replaced})
+	}
+	return out
+}
@@ -0,0 +1,115 @@
+package physics
+
+import (
+	"collision/circle"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// at - placement at position with rotation angle
+func at(x, y, angle float64) transform {
+	return transform{position: Vec2{X: x, Y: y}, rot: newRotation(angle)}
+}
+
+// assertVec - assert that two vectors are equal to within delta
+func assertVec(t *testing.T, expected, actual Vec2, msgAndArgs ...any) {
+	t.Helper()
+	assert.InDelta(t, expected.X, actual.X, 1e-9, msgAndArgs...)
+	assert.InDelta(t, expected.Y, actual.Y, 1e-9, msgAndArgs...)
+}
+
+// TestCollideCircles - test manifold of overlapping, touching and separate disks
+func TestCollideCircles(t *testing.T) {
+	disk := NewCircleShape(circle.NewCircle(0, 0, 1))
+	m, ok := collide(disk, at(0, 0, 0), disk, at(1.5, 0, 0))
+	assert.True(t, ok)
+	assertVec(t, Vec2{X: 1, Y: 0}, m.normal)
+	assert.Len(t, m.points, 1)
+	assert.InDelta(t, -0.5, m.points[0].separation, 1e-9)
+	assertVec(t, Vec2{X: 0.75, Y: 0}, m.points[0].position, "expect point midway between the surfaces")
+
+	_, ok = collide(disk, at(0, 0, 0), disk, at(0, 2, 0))
+	assert.True(t, ok, "expect touching disks to collide")
+	_, ok = collide(disk, at(0, 0, 0), disk, at(2.1, 0, 0))
+	assert.False(t, ok)
+}
+
+// TestCollidePolygonCircle - test face and vertex regions, and the normal direction when the order is swapped
+func TestCollidePolygonCircle(t *testing.T) {
+	square := box(t, -1, -1, 1, 1)
+	disk := NewCircleShape(circle.NewCircle(0, 0, 0.5))
+
+	// above the top face
+	m, ok := collide(square, at(0, 0, 0), disk, at(0.3, 1.25, 0))
+	assert.True(t, ok)
+	assertVec(t, Vec2{X: 0, Y: 1}, m.normal)
+	assert.InDelta(t, -0.25, m.points[0].separation, 1e-9)
+	assertVec(t, Vec2{X: 0.3, Y: 0.875}, m.points[0].position)
+
+	// beyond the top right corner
+	m, ok = collide(square, at(0, 0, 0), disk, at(1.3, 1.4, 0))
+	assert.True(t, ok)
+	assertVec(t, Vec2{X: 0.6, Y: 0.8}, m.normal)
+	assert.InDelta(t, 0, m.points[0].separation, 1e-9)
+	_, ok = collide(square, at(0, 0, 0), disk, at(1.4, 1.4, 0))
+	assert.False(t, ok, "expect disk clear of the corner though within reach of both faces")
+
+	// centre inside the square
+	m, ok = collide(square, at(0, 0, 0), disk, at(0.8, 0, 0))
+	assert.True(t, ok)
+	assertVec(t, Vec2{X: 1, Y: 0}, m.normal)
+	assert.InDelta(t, -0.7, m.points[0].separation, 1e-9)
+
+	// disk first, so normal points from disk to square
+	m, ok = collide(disk, at(0.3, 1.25, 0), square, at(0, 0, 0))
+	assert.True(t, ok)
+	assertVec(t, Vec2{X: 0, Y: -1}, m.normal)
+
+	// rotated square
+	m, ok = collide(square, at(0, 0, math.Pi/4), disk, at(0, 1.8, 0))
+	assert.True(t, ok, "expect corner of diamond at height sqrt 2 to reach disk")
+	assertVec(t, Vec2{X: 0, Y: 1}, m.normal)
+	assert.InDelta(t, 1.3-math.Sqrt2, m.points[0].separation, 1e-9)
+}
+
+// TestCollidePolygons - test manifold of a box resting on a wider box, and of boxes apart
+func TestCollidePolygons(t *testing.T) {
+	ground := box(t, -5, -1, 5, 1)
+	square := box(t, -1, -1, 1, 1)
+
+	m, ok := collide(ground, at(0, 0, 0), square, at(0, 1.9, 0))
+	assert.True(t, ok)
+	assertVec(t, Vec2{X: 0, Y: 1}, m.normal)
+	assert.Len(t, m.points, 2, "expect both corners of the bottom face")
+	for _, p := range m.points {
+		assert.InDelta(t, -0.1, p.separation, 1e-9)
+		assert.InDelta(t, 0.95, p.position.Y, 1e-9)
+		assert.InDelta(t, 1, math.Abs(p.position.X), 1e-9)
+	}
+	assert.NotEqual(t, m.points[0].id, m.points[1].id)
+
+	// order swapped, the reference face is now on the second shape
+	swapped, ok := collide(square, at(0, 1.9, 0), ground, at(0, 0, 0))
+	assert.True(t, ok)
+	assertVec(t, Vec2{X: 0, Y: -1}, swapped.normal)
+	assert.Len(t, swapped.points, 2)
+
+	// overhanging the end of the ground, the bottom face is clipped to the ground's side
+	m, ok = collide(ground, at(0, 0, 0), square, at(5, 1.9, 0))
+	assert.True(t, ok)
+	assert.Len(t, m.points, 2)
+	xs := []float64{m.points[0].position.X, m.points[1].position.X}
+	assert.ElementsMatch(t, []float64{4, 5}, xs)
+
+	// tilted slightly, only one corner touches
+	m, ok = collide(ground, at(0, 0, 0), square, at(0, 2.05, 0.1))
+	assert.True(t, ok)
+	assert.Len(t, m.points, 1)
+
+	_, ok = collide(ground, at(0, 0, 0), square, at(0, 2.1, 0))
+	assert.False(t, ok)
+	_, ok = collide(ground, at(0, 0, 0), square, at(6.1, 0, 0))
+	assert.False(t, ok)
+}
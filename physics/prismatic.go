@@ -0,0 +1,149 @@
+package physics
+
+import "math"
+
+// PrismaticJoint - joint letting two bodies slide along an axis fixed in the first body without turning
+// relative to each other, like a piston or a drawer. The translation along the axis can be limited, and a motor
+// can drive the bodies along it.
+type PrismaticJoint struct {
+	jointBase
+	EnableLimit      bool    // keep the joint translation between LowerTranslation and UpperTranslation
+	LowerTranslation float64 // least joint translation, when the limit is enabled
+	UpperTranslation float64 // greatest joint translation, when the limit is enabled
+	EnableMotor      bool    // drive the joint at MotorSpeed
+	MotorSpeed       float64 // target speed of the second body along the axis relative to the first
+	MaxMotorForce    float64 // greatest force the motor applies
+	localAxis        Vec2    // unit axis in the first body's coordinates
+	referenceAngle   float64 // angle of the second body relative to the first, held by the joint
+	axis, perp       Vec2    // unit axis and a right angle counterclockwise of it, in world coordinates
+	axialArmA        float64 // moment arms of an impulse along the axis about the centres of mass
+	axialArmB        float64
+	perpArmA         float64 // moment arms of an impulse across the axis about the centres of mass
+	perpArmB         float64
+	axialMass        float64 // effective mass along the axis
+	mass             mat22   // inverse of effective mass across the axis and against rotation
+	bias             Vec2    // speeds correcting drift across the axis and rotation
+	lowerBias        float64 // speed correcting the lower limit
+	upperBias        float64 // speed correcting the upper limit
+	maxMotorImpulse  float64 // greatest impulse the motor applies in a step
+	impulse          Vec2    // impulses accumulated across the axis and against rotation, kept for warm starting
+	motorImpulse     float64 // impulses accumulated by the motor and limits along the axis, kept for warm starting
+	lowerImpulse     float64
+	upperImpulse     float64
+}
+
+// NewPrismaticJoint - returns a pointer to a joint letting the bodies slide along axis through an anchor, both
+// given in world coordinates, with a joint translation of zero in their current placement
+func NewPrismaticJoint(a, b *Body, anchor, axis Vec2) *PrismaticJoint {
+	return &PrismaticJoint{
+		jointBase:      newJointBase(a, b, anchor, anchor),
+		localAxis:      newRotation(a.Angle).inverse(axis.Unit()),
+		referenceAngle: b.Angle - a.Angle,
+	}
+}
+
+// JointTranslation - distance of the second body's anchor from the first's along the axis
+func (j *PrismaticJoint) JointTranslation() float64 {
+	rot := newRotation(j.a.Angle)
+	d := j.b.WorldPoint(j.localB).Sub(j.a.WorldPoint(j.localA))
+	return d.Dot(rot.apply(j.localAxis))
+}
+
+// preStep - compute effective masses and bias speeds, and apply the impulses carried over from the previous
+// step if warm starting
+func (j *PrismaticJoint) preStep(invDt float64, warmStart bool) {
+	j.anchors()
+	mA, mB, iA, iB := j.a.invMass, j.b.invMass, j.a.invInertia, j.b.invInertia
+	d := j.separation()
+	j.axis = newRotation(j.a.Angle).apply(j.localAxis)
+	j.perp = Vec2{X: -j.axis.Y, Y: j.axis.X}
+
+	// the first body's arm reaches the second body's anchor, as the axis turns with the first body
+	j.axialArmA, j.axialArmB = d.Add(j.rA).Cross(j.axis), j.rB.Cross(j.axis)
+	j.perpArmA, j.perpArmB = d.Add(j.rA).Cross(j.perp), j.rB.Cross(j.perp)
+	if k := mA + mB + iA*j.axialArmA*j.axialArmA + iB*j.axialArmB*j.axialArmB; k > 0 {
+		j.axialMass = 1 / k
+	} else {
+		j.axialMass = 0
+	}
+	angular := iA + iB
+	if angular == 0 {
+		// neither body can turn, so rotation is held without any impulse
+		angular = 1
+	}
+	offDiagonal := iA*j.perpArmA + iB*j.perpArmB
+	j.mass = mat22{
+		a: mA + mB + iA*j.perpArmA*j.perpArmA + iB*j.perpArmB*j.perpArmB, b: offDiagonal,
+		c: offDiagonal, d: angular,
+	}
+	j.bias = Vec2{X: d.Dot(j.perp), Y: j.b.Angle - j.a.Angle - j.referenceAngle}.Scale(baumgarte * invDt)
+
+	translation := d.Dot(j.axis)
+	j.lowerBias = limitBias(translation-j.LowerTranslation, invDt)
+	j.upperBias = limitBias(j.UpperTranslation-translation, invDt)
+	j.maxMotorImpulse = j.MaxMotorForce / invDt
+	if !warmStart {
+		j.impulse = Vec2{}
+	}
+	if !j.EnableMotor || !warmStart {
+		j.motorImpulse = 0
+	}
+	if !j.EnableLimit || !warmStart {
+		j.lowerImpulse, j.upperImpulse = 0, 0
+	}
+	j.applyAxial(j.motorImpulse + j.lowerImpulse - j.upperImpulse)
+	j.applyFixed(j.impulse)
+}
+
+// solve - one pass of sequential impulses over the motor, the limits and then the constraints across the axis
+// and against rotation, which are solved last so they are held most closely
+func (j *PrismaticJoint) solve() {
+	if j.EnableMotor {
+		lambda := -j.axialMass * (j.axialSpeed() - j.MotorSpeed)
+		accumulated := math.Max(-j.maxMotorImpulse, math.Min(j.motorImpulse+lambda, j.maxMotorImpulse))
+		lambda, j.motorImpulse = accumulated-j.motorImpulse, accumulated
+		j.applyAxial(lambda)
+	}
+	if j.EnableLimit {
+		lambda := -j.axialMass * (j.axialSpeed() + j.lowerBias)
+		accumulated := math.Max(j.lowerImpulse+lambda, 0)
+		lambda, j.lowerImpulse = accumulated-j.lowerImpulse, accumulated
+		j.applyAxial(lambda)
+
+		lambda = -j.axialMass * (-j.axialSpeed() + j.upperBias)
+		accumulated = math.Max(j.upperImpulse+lambda, 0)
+		lambda, j.upperImpulse = accumulated-j.upperImpulse, accumulated
+		j.applyAxial(-lambda)
+	}
+	a, b := j.a, j.b
+	speed := Vec2{
+		X: j.perp.Dot(b.Velocity.Sub(a.Velocity)) + j.perpArmB*b.AngularVelocity - j.perpArmA*a.AngularVelocity,
+		Y: b.AngularVelocity - a.AngularVelocity,
+	}
+	lambda := j.mass.solve(speed.Add(j.bias)).Neg()
+	j.impulse = j.impulse.Add(lambda)
+	j.applyFixed(lambda)
+}
+
+// axialSpeed - speed of the second body's anchor along the axis relative to the first's
+func (j *PrismaticJoint) axialSpeed() float64 {
+	a, b := j.a, j.b
+	return j.axis.Dot(b.Velocity.Sub(a.Velocity)) + j.axialArmB*b.AngularVelocity - j.axialArmA*a.AngularVelocity
+}
+
+// applyAxial - apply an impulse along the axis
+func (j *PrismaticJoint) applyAxial(impulse float64) {
+	j.applyAlong(j.axis, j.axialArmA, j.axialArmB, impulse)
+}
+
+// applyFixed - apply an impulse across the axis and an angular impulse
+func (j *PrismaticJoint) applyFixed(impulse Vec2) {
+	j.applyAlong(j.perp, j.perpArmA, j.perpArmB, impulse.X)
+	j.applyAngular(impulse.Y)
+}
+
+// impulses - impulses applied to the second body in the current step
+func (j *PrismaticJoint) impulses() (Vec2, float64) {
+	axial := j.motorImpulse + j.lowerImpulse - j.upperImpulse
+	return j.perp.Scale(j.impulse.X).Add(j.axis.Scale(axial)), j.impulse.Y
+}
@@ -0,0 +1,54 @@
+package physics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPrismaticJointSlide - test that a body on a diagonal slider moves only along it, without turning, and stops
+// at its limits
+func TestPrismaticJointSlide(t *testing.T) {
+	w, ground := newTestWorld(t)
+	slider := NewBody(DynamicBody, box(t, 0, 2, 1, 3), 1)
+	assert.Nil(t, w.AddBody(slider))
+	j := NewPrismaticJoint(ground, slider, slider.Position, Vec2{X: 1, Y: 1})
+	j.EnableLimit, j.LowerTranslation, j.UpperTranslation = true, -1, 1
+	assert.Nil(t, w.AddJoint(j))
+
+	// gravity pulls the slider down the slope to its lower limit
+	start := slider.Position
+	run(w, 120)
+	assert.InDelta(t, -1, j.JointTranslation(), 0.01)
+	offset := slider.Position.Sub(start)
+	assert.InDelta(t, offset.X, offset.Y, 1e-3, "expect slider to stay on the axis")
+	assert.InDelta(t, 0, slider.Angle, 1e-3)
+
+	// knocked sideways and spun, it still only moves along the axis, up to its upper limit
+	slider.Velocity = Vec2{X: 10, Y: 0}
+	slider.AngularVelocity = 5
+	slider.SetAwake(true)
+	highest := j.JointTranslation()
+	for i := 0; i < 30; i++ {
+		run(w, 1)
+		highest = max(highest, j.JointTranslation())
+		offset = slider.Position.Sub(start)
+		assert.InDelta(t, offset.X, offset.Y, 1e-3)
+		assert.InDelta(t, 0, slider.Angle, 1e-3)
+	}
+	assert.InDelta(t, 1, highest, 0.01)
+}
+
+// TestPrismaticJointMotor - test that a motor drives a body along the axis against gravity
+func TestPrismaticJointMotor(t *testing.T) {
+	w, ground := newTestWorld(t)
+	lift := NewBody(DynamicBody, box(t, 0, 1, 1, 2), 1)
+	assert.Nil(t, w.AddBody(lift))
+	j := NewPrismaticJoint(ground, lift, lift.Position, Vec2{X: 0, Y: 1})
+	j.EnableMotor, j.MotorSpeed, j.MaxMotorForce = true, 1, 100
+	assert.Nil(t, w.AddJoint(j))
+	run(w, 60)
+	assert.InDelta(t, 1, lift.Velocity.Y, 1e-6)
+	assert.InDelta(t, 1, j.JointTranslation(), 0.02)
+	assert.InDelta(t, lift.Mass()*10, j.ReactionForce().Y, 1e-3)
+}
@@ -0,0 +1,87 @@
+package physics
+
+import "math"
+
+// RevoluteJoint - joint pinning two bodies together at an anchor about which they turn freely, like a hinge or
+// an axle. The angle between them can be limited, and a motor can drive them round.
+type RevoluteJoint struct {
+	jointBase
+	EnableLimit     bool    // keep the joint angle between LowerAngle and UpperAngle
+	LowerAngle      float64 // least joint angle in radians, when the limit is enabled
+	UpperAngle      float64 // greatest joint angle in radians, when the limit is enabled
+	EnableMotor     bool    // drive the joint at MotorSpeed
+	MotorSpeed      float64 // target speed of the second body relative to the first in radians per second
+	MaxMotorTorque  float64 // greatest torque the motor applies
+	referenceAngle  float64 // angle of the second body relative to the first when the joint angle is zero
+	point           pointConstraint
+	mass            float64 // effective mass against relative rotation
+	lowerBias       float64 // speed correcting the lower limit
+	upperBias       float64 // speed correcting the upper limit
+	maxMotorImpulse float64 // greatest impulse the motor applies in a step
+	motorImpulse    float64 // impulses accumulated by the motor and limits, kept between steps for warm starting
+	lowerImpulse    float64
+	upperImpulse    float64
+}
+
+// NewRevoluteJoint - returns a pointer to a joint pinning the bodies together at an anchor given in world
+// coordinates, with a joint angle of zero in their current placement
+func NewRevoluteJoint(a, b *Body, anchor Vec2) *RevoluteJoint {
+	return &RevoluteJoint{jointBase: newJointBase(a, b, anchor, anchor), referenceAngle: b.Angle - a.Angle}
+}
+
+// JointAngle - angle of the second body relative to the first, zero when the joint was made
+func (j *RevoluteJoint) JointAngle() float64 {
+	return j.b.Angle - j.a.Angle - j.referenceAngle
+}
+
+// JointSpeed - angular velocity of the second body relative to the first
+func (j *RevoluteJoint) JointSpeed() float64 {
+	return j.b.AngularVelocity - j.a.AngularVelocity
+}
+
+// preStep - compute effective masses and bias speeds, and apply the impulses carried over from the previous
+// step if warm starting
+func (j *RevoluteJoint) preStep(invDt float64, warmStart bool) {
+	j.anchors()
+	j.mass = j.angularMass()
+	angle := j.JointAngle()
+	j.lowerBias = limitBias(angle-j.LowerAngle, invDt)
+	j.upperBias = limitBias(j.UpperAngle-angle, invDt)
+	j.maxMotorImpulse = j.MaxMotorTorque / invDt
+	if !j.EnableMotor || !warmStart {
+		j.motorImpulse = 0
+	}
+	if !j.EnableLimit || !warmStart {
+		j.lowerImpulse, j.upperImpulse = 0, 0
+	}
+	j.applyAngular(j.motorImpulse + j.lowerImpulse - j.upperImpulse)
+	j.point.prepare(&j.jointBase, invDt, warmStart)
+}
+
+// solve - one pass of sequential impulses over the motor, the limits and then the anchors, which are solved
+// last so they are held together most closely
+func (j *RevoluteJoint) solve() {
+	if j.EnableMotor {
+		lambda := -j.mass * (j.JointSpeed() - j.MotorSpeed)
+		accumulated := math.Max(-j.maxMotorImpulse, math.Min(j.motorImpulse+lambda, j.maxMotorImpulse))
+		lambda, j.motorImpulse = accumulated-j.motorImpulse, accumulated
+		j.applyAngular(lambda)
+	}
+	if j.EnableLimit {
+		lambda := -j.mass * (j.JointSpeed() + j.lowerBias)
+		accumulated := math.Max(j.lowerImpulse+lambda, 0)
+		lambda, j.lowerImpulse = accumulated-j.lowerImpulse, accumulated
+		j.applyAngular(lambda)
+
+		lambda = -j.mass * (-j.JointSpeed() + j.upperBias)
+		accumulated = math.Max(j.upperImpulse+lambda, 0)
+		lambda, j.upperImpulse = accumulated-j.upperImpulse, accumulated
+		j.applyAngular(-lambda)
+	}
+	j.point.solve(&j.jointBase)
+}
+
+// impulses - impulses applied to the second body in the current step
+func (j *RevoluteJoint) impulses() (Vec2, float64) {
+	return j.point.impulse, j.motorImpulse + j.lowerImpulse - j.upperImpulse
+}
@@ -0,0 +1,68 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newDoor - world without gravity holding a door of width 2 and unit height hinged to the ground at its left edge
+func newDoor(t *testing.T) (*World, *Body, *RevoluteJoint) {
+	w, ground := newTestWorld(t)
+	w.Gravity = Vec2{}
+	door := NewBody(DynamicBody, box(t, 0, 2, 2, 3), 1)
+	assert.Nil(t, w.AddBody(door))
+	hinge := NewRevoluteJoint(ground, door, Vec2{X: 0, Y: 2.5})
+	assert.Nil(t, w.AddJoint(hinge))
+	return w, door, hinge
+}
+
+// TestRevoluteJointPivot - test that a hinged body turns about the anchor without moving it
+func TestRevoluteJointPivot(t *testing.T) {
+	w, door, hinge := newDoor(t)
+	door.Velocity, door.AngularVelocity = Vec2{X: 0, Y: 1}, 1
+	run(w, 60)
+	assert.InDelta(t, 1, hinge.JointAngle(), 0.01)
+	assert.InDelta(t, 1, hinge.JointSpeed(), 0.01)
+	assert.InDelta(t, 0, door.WorldPoint(hinge.localB).Sub(Vec2{X: 0, Y: 2.5}).Length(), 1e-3)
+}
+
+// TestRevoluteJointLimits - test that a paddle pinned at its centre stops turning at the limits of its angle
+func TestRevoluteJointLimits(t *testing.T) {
+	w, ground := newTestWorld(t)
+	w.Gravity = Vec2{}
+	paddle := NewBody(DynamicBody, box(t, -1, 2, 1, 3), 1)
+	assert.Nil(t, w.AddBody(paddle))
+	hinge := NewRevoluteJoint(ground, paddle, paddle.Position)
+	hinge.EnableLimit, hinge.LowerAngle, hinge.UpperAngle = true, -math.Pi/4, math.Pi/2
+	assert.Nil(t, w.AddJoint(hinge))
+
+	paddle.AngularVelocity = 3
+	run(w, 60)
+	assert.InDelta(t, math.Pi/2, hinge.JointAngle(), 0.01)
+	assert.InDelta(t, 0, paddle.AngularVelocity, 1e-6)
+
+	paddle.AngularVelocity = -3
+	run(w, 60)
+	assert.InDelta(t, -math.Pi/4, hinge.JointAngle(), 0.01)
+	assert.InDelta(t, 0, paddle.AngularVelocity, 1e-6)
+}
+
+// TestRevoluteJointMotor - test that a motor turns a hinged body at its speed, unless it lacks the torque to
+func TestRevoluteJointMotor(t *testing.T) {
+	w, door, hinge := newDoor(t)
+	hinge.EnableMotor, hinge.MotorSpeed, hinge.MaxMotorTorque = true, 2, 1000
+	run(w, 10)
+	assert.InDelta(t, 2, door.AngularVelocity, 1e-3)
+	assert.InDelta(t, 20*w.TimeStep, hinge.JointAngle(), 0.01)
+
+	// held back by a torque greater than it can apply, the motor is overcome
+	hinge.MaxMotorTorque = 1
+	for i := 0; i < 60; i++ {
+		door.ApplyTorque(-10)
+		run(w, 1)
+	}
+	assert.Less(t, door.AngularVelocity, 0.0)
+	assert.InDelta(t, 1, hinge.ReactionTorque(), 1e-9)
+}
@@ -0,0 +1,72 @@
+package physics
+
+// SensorEvent - a body entering or leaving the shape of a sensor
+type SensorEvent struct {
+	Sensor  *Body // sensor body whose shape was entered or left
+	Visitor *Body // body that entered or left it
+}
+
+// SensorCallback - function called when a body enters or leaves a sensor. Callbacks run once a step has
+// finished, so they may add, remove or change bodies.
+type SensorCallback func(SensorEvent)
+
+// sensorEvent - event for a pair of bodies, one of which is a sensor
+func sensorEvent(p pair) SensorEvent {
+	if p.a.Sensor {
+		return SensorEvent{Sensor: p.a, Visitor: p.b}
+	}
+	return SensorEvent{Sensor: p.b, Visitor: p.a}
+}
+
+// sensorEvents - OnSensorExit events for pairs no longer overlapping, followed by OnSensorEnter events for
+// pairs that have started to
+func (w *World) sensorEvents(exited, entered []pair) events {
+	var e events
+	add := func(callback SensorCallback, p pair) {
+		if callback != nil {
+			event := sensorEvent(p)
+			e = append(e, func() { callback(event) })
+		}
+	}
+	for _, p := range exited {
+		add(w.OnSensorExit, p)
+	}
+	for _, p := range entered {
+		add(w.OnSensorEnter, p)
+	}
+	return e
+}
+
+// SensorOverlaps - bodies overlapping a sensor at the end of the last step, in order of ID
+func (w *World) SensorOverlaps(sensor *Body) []*Body {
+	var visitors []*Body
+	for _, p := range w.overlaps {
+		if event := sensorEvent(p); event.Sensor == sensor {
+			visitors = append(visitors, event.Visitor)
+		}
+	}
+	return visitors
+}
+
+// updateOverlaps - replace sensor overlaps with those between bodies overlapping now, returning those that
+// have ended and those that have begun
+func (w *World) updateOverlaps(overlaps []pair) (exited, entered []pair) {
+	previous := make(map[pairKey]bool, len(w.overlaps))
+	for _, p := range w.overlaps {
+		previous[p.key()] = true
+	}
+	for _, p := range overlaps {
+		if previous[p.key()] {
+			delete(previous, p.key())
+		} else {
+			entered = append(entered, p)
+		}
+	}
+	for _, p := range w.overlaps {
+		if previous[p.key()] {
+			exited = append(exited, p)
+		}
+	}
+	w.overlaps = overlaps
+	return exited, entered
+}
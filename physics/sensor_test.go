@@ -0,0 +1,72 @@
+package physics
+
+import (
+	"collision/circle"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSensor - test that a ball falls through a trigger zone untouched, entering and leaving it once
+func TestSensor(t *testing.T) {
+	w := NewWorld(Vec2{X: 0, Y: -10})
+	zone := NewBody(StaticBody, box(t, -2, -1, 2, 1), 1)
+	zone.Sensor = true
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 2, 0.5)), 1)
+	wall := NewBody(StaticBody, box(t, 1, -1, 3, 1), 1)
+	for _, b := range []*Body{zone, ball, wall} {
+		assert.Nil(t, w.AddBody(b))
+	}
+	var entered, exited []SensorEvent
+	w.OnSensorEnter = func(e SensorEvent) { entered = append(entered, e) }
+	w.OnSensorExit = func(e SensorEvent) { exited = append(exited, e) }
+	contacts := 0
+	w.OnBegin = func(Contact) { contacts++ }
+
+	// fall until inside the zone
+	steps := 0
+	for len(entered) == 0 {
+		run(w, 1)
+		steps++
+	}
+	assert.Equal(t, []SensorEvent{{Sensor: zone, Visitor: ball}}, entered)
+	assert.Equal(t, []*Body{ball}, w.SensorOverlaps(zone))
+	assert.Empty(t, w.SensorOverlaps(ball))
+
+	// keep falling out of the bottom without being slowed
+	run(w, 60)
+	steps += 60
+	assert.Equal(t, []SensorEvent{{Sensor: zone, Visitor: ball}}, exited)
+	assert.Len(t, entered, 1)
+	assert.Empty(t, w.SensorOverlaps(zone))
+	assert.InDelta(t, -10*float64(steps)*w.TimeStep, ball.Velocity.Y, 1e-9, "expect sensor not to slow the ball")
+	assert.Equal(t, 0, contacts, "expect no contact between sensor and wall or ball")
+}
+
+// TestSensorPairs - test which kinds of body a sensor notices
+func TestSensorPairs(t *testing.T) {
+	w := NewWorld(Vec2{})
+	zone := NewBody(StaticBody, NewCircleShape(circle.NewCircle(0, 0, 5)), 1)
+	zone.Sensor = true
+	moving := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(-4, 0, 0.5)), 1)
+	moving.Sensor = true
+	platform := NewBody(KinematicBody, box(t, 0, 0, 1, 1), 1)
+	pickup := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, -3, 0.5)), 1)
+	pickup.Filter = Filter{Category: 2, Mask: 1}
+	zone.Filter = Filter{Category: 4, Mask: 0xFFFFFFFF}
+	for _, b := range []*Body{zone, moving, platform, pickup} {
+		assert.Nil(t, w.AddBody(b))
+	}
+	var entered []SensorEvent
+	w.OnSensorEnter = func(e SensorEvent) { entered = append(entered, e) }
+	run(w, 1)
+	// a sensor does not notice another sensor, and a filtered body is not noticed
+	assert.Equal(t, []SensorEvent{{Sensor: zone, Visitor: platform}}, entered)
+
+	// removing a body inside a sensor leaves it
+	var exited []SensorEvent
+	w.OnSensorExit = func(e SensorEvent) { exited = append(exited, e) }
+	assert.Nil(t, w.RemoveBody(platform))
+	assert.Equal(t, []SensorEvent{{Sensor: zone, Visitor: platform}}, exited)
+	assert.Empty(t, w.SensorOverlaps(zone))
+}
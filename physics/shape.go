@@ -0,0 +1,178 @@
+package physics
+
+import (
+	"collision/circle"
+	"collision/point"
+	"collision/polygon"
+	"errors"
+	"math"
+)
+
+// ShapeKind - kind of geometry a shape has
+type ShapeKind int
+
+const (
+	ShapeCircle  ShapeKind = iota // disk
+	ShapePolygon                  // convex polygon
+)
+
+// Shape - collision geometry of a body, in body coordinates once the body has been created. Shapes are built
+// from the collision primitives in world coordinates and moved into body coordinates by NewBody.
+type Shape struct {
+	kind     ShapeKind
+	radius   float64 // radius of circle
+	centre   Vec2    // centre of circle
+	vertices []Vec2  // vertices of polygon, counterclockwise
+	normals  []Vec2  // outward unit normal of each polygon edge, edge i running from vertex i to vertex i+1
+}
+
+// NewCircleShape - returns a disk shape matching the circle
+func NewCircleShape(c circle.Circle) Shape {
+	centre, radius := c.GetCentreAndRadius()
+	return Shape{kind: ShapeCircle, radius: float64(radius), centre: VecFromPoint(centre)}
+}
+
+// MaxPolygonVertices - most vertices a polygon shape may have, so that each contact point can be identified from
+// step to step by the edge and vertex it came from
+const MaxPolygonVertices = 256
+
+// ErrTooManyVertices - polygon has more than MaxPolygonVertices vertices
+var ErrTooManyVertices = errors.New("polygon has too many vertices for a shape")
+
+// NewPolygonShape - returns a convex polygon shape matching the polygon. Returns an error if the polygon is
+// invalid, polygon.ErrNotConvex if it is not convex, or ErrTooManyVertices if it has more than
+// MaxPolygonVertices vertices.
+func NewPolygonShape(p *polygon.XYPolygon) (Shape, error) {
+	if len(p.Vertices) > MaxPolygonVertices {
+		return Shape{}, ErrTooManyVertices
+	}
+	if err := p.ValidatePolygon(); err != nil {
+		return Shape{}, err
+	}
+	if !p.IsConvex() {
+		return Shape{}, polygon.ErrNotConvex
+	}
+	return newPolygonShape(p.Vertices), nil
+}
+
+// NewRectangleShape - returns a polygon shape matching the rectangle
+func NewRectangleShape(r *polygon.XYRectangle) Shape {
+	return newPolygonShape(r.Vertices[:])
+}
+
+// newPolygonShape - polygon shape from the vertices of a valid convex polygon in either winding
+func newPolygonShape(points []point.Point) Shape {
+	vertices := make([]Vec2, len(points))
+	for i, p := range points {
+		vertices[i] = VecFromPoint(p)
+	}
+	s := Shape{kind: ShapePolygon, vertices: vertices}
+	if s.signedArea() < 0 {
+		// polygons in this module are clockwise, but edge normals are simplest on the right of counterclockwise edges
+		for i, j := 0, len(vertices)-1; i < j; i, j = i+1, j-1 {
+			vertices[i], vertices[j] = vertices[j], vertices[i]
+		}
+	}
+	s.populateNormals()
+	return s
+}
+
+// Kind - kind of geometry of shape
+func (s Shape) Kind() ShapeKind {
+	return s.kind
+}
+
+// Radius - radius of a circle shape, zero for a polygon
+func (s Shape) Radius() float64 {
+	return s.radius
+}
+
+// Centre - centre of a circle shape
+func (s Shape) Centre() Vec2 {
+	return s.centre
+}
+
+// Vertices - copy of the vertices of a polygon shape, counterclockwise, nil for a circle
+func (s Shape) Vertices() []Vec2 {
+	if s.kind != ShapePolygon {
+		return nil
+	}
+	return append([]Vec2{}, s.vertices...)
+}
+
+// populateNormals - outward normals of polygon edges
+func (s *Shape) populateNormals() {
+	order := len(s.vertices)
+	s.normals = make([]Vec2, order)
+	for i := range s.vertices {
+		edge := s.vertices[(i+1)%order].Sub(s.vertices[i])
+		s.normals[i] = Vec2{X: edge.Y, Y: -edge.X}.Unit()
+	}
+}
+
+// signedArea - area of polygon, positive if counterclockwise
+func (s Shape) signedArea() float64 {
+	var area float64
+	order := len(s.vertices)
+	for i := range s.vertices {
+		area += s.vertices[i].Cross(s.vertices[(i+1)%order])
+	}
+	return area / 2
+}
+
+// massData - mass, rotational inertia about the centroid and centroid of shape of uniform density
+func (s Shape) massData(density float64) (mass, inertia float64, centroid Vec2) {
+	if s.kind == ShapeCircle {
+		mass = density * math.Pi * s.radius * s.radius
+		return mass, mass * s.radius * s.radius / 2, s.centre
+	}
+	// sum over triangles fanned from the first vertex, with inertia taken about that vertex
+	origin := s.vertices[0]
+	var area, inertiaAboutOrigin float64
+	var weighted Vec2
+	for i := 1; i+1 < len(s.vertices); i++ {
+		e1, e2 := s.vertices[i].Sub(origin), s.vertices[i+1].Sub(origin)
+		triangleArea := e1.Cross(e2) / 2
+		area += triangleArea
+		weighted = weighted.Add(e1.Add(e2).Scale(triangleArea / 3))
+		intX := e1.X*e1.X + e2.X*e1.X + e2.X*e2.X
+		intY := e1.Y*e1.Y + e2.Y*e1.Y + e2.Y*e2.Y
+		inertiaAboutOrigin += e1.Cross(e2) / 12 * (intX + intY)
+	}
+	mass = density * area
+	offset := weighted.Scale(1 / area)
+	centroid = origin.Add(offset)
+	// parallel axis theorem moves inertia from the first vertex to the centroid
+	inertia = density*inertiaAboutOrigin - mass*offset.LengthSquared()
+	return mass, inertia, centroid
+}
+
+// translated - copy of shape moved by offset
+func (s Shape) translated(offset Vec2) Shape {
+	moved := s
+	moved.centre = s.centre.Add(offset)
+	if s.vertices != nil {
+		moved.vertices = make([]Vec2, len(s.vertices))
+		for i, v := range s.vertices {
+			moved.vertices[i] = v.Add(offset)
+		}
+		moved.normals = append([]Vec2{}, s.normals...)
+	}
+	return moved
+}
+
+// aabb - bounds of shape placed at position and rotated by rot
+func (s Shape) aabb(position Vec2, rot rotation) AABB {
+	if s.kind == ShapeCircle {
+		centre := position.Add(rot.apply(s.centre))
+		extent := Vec2{X: s.radius, Y: s.radius}
+		return AABB{Min: centre.Sub(extent), Max: centre.Add(extent)}
+	}
+	box := AABB{Min: Vec2{X: math.Inf(1), Y: math.Inf(1)}, Max: Vec2{X: math.Inf(-1), Y: math.Inf(-1)}}
+	for _, v := range s.vertices {
+		w := position.Add(rot.apply(v))
+		box.Min = Vec2{X: math.Min(box.Min.X, w.X), Y: math.Min(box.Min.Y, w.Y)}
+		box.Max = Vec2{X: math.Max(box.Max.X, w.X), Y: math.Max(box.Max.Y, w.Y)}
+	}
+	return box
+}
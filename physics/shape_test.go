@@ -0,0 +1,84 @@
+package physics
+
+import (
+	"collision/circle"
+	"collision/point"
+	"collision/polygon"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// box - rectangle shape with opposite corners at (x0, y0) and (x1, y1)
+func box(t *testing.T, x0, y0, x1, y1 float32) Shape {
+	r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: x0, Y: y0}, {X: x1, Y: y1}})
+	assert.Nil(t, err)
+	return NewRectangleShape(r)
+}
+
+// TestNewPolygonShape - test that polygon shapes are wound counterclockwise and reject concave polygons and
+// polygons with too many vertices
+func TestNewPolygonShape(t *testing.T) {
+	// clockwise triangle
+	triangle, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 0, Y: 0}, {X: 0, Y: 3}, {X: 3, Y: 0}})
+	assert.Nil(t, err)
+	s, err := NewPolygonShape(triangle)
+	assert.Nil(t, err)
+	assert.Equal(t, ShapePolygon, s.Kind())
+	assert.Equal(t, []Vec2{{X: 3, Y: 0}, {X: 0, Y: 3}, {X: 0, Y: 0}}, s.Vertices(), "expect winding reversed to counterclockwise")
+	assert.Greater(t, s.signedArea(), 0.0)
+
+	notched, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 3, Y: 0}, {X: 3, Y: 4}, {X: 5, Y: 2}, {X: 7, Y: 4}, {X: 7, Y: 0}})
+	assert.Nil(t, err)
+	_, err = NewPolygonShape(notched)
+	assert.ErrorIs(t, err, polygon.ErrNotConvex)
+
+	_, err = NewPolygonShape(&polygon.XYPolygon{Vertices: []point.Point{{X: 0, Y: 0}, {X: 1, Y: 1}}})
+	assert.ErrorIs(t, err, polygon.ErrInvalidPolygon)
+
+	// polygons with so many vertices that contact points could not be told apart
+	for _, order := range []int{MaxPolygonVertices, MaxPolygonVertices + 1} {
+		vertices := make([]point.Point, order)
+		for i := range vertices {
+			angle := -2 * math.Pi * float64(i) / float64(order)
+			vertices[i] = point.Point{X: float32(1000 * math.Cos(angle)), Y: float32(1000 * math.Sin(angle))}
+		}
+		_, err = NewPolygonShape(&polygon.XYPolygon{Vertices: vertices})
+		if order > MaxPolygonVertices {
+			assert.ErrorIs(t, err, ErrTooManyVertices)
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+}
+
+// TestShapeMassData - test mass, inertia and centroid against the formulae for a disk and a rectangle
+func TestShapeMassData(t *testing.T) {
+	mass, inertia, centroid := NewCircleShape(circle.NewCircle(1, 2, 2)).massData(3)
+	assert.InDelta(t, 12*math.Pi, mass, 1e-9)
+	assert.InDelta(t, 24*math.Pi, inertia, 1e-9)
+	assert.Equal(t, Vec2{X: 1, Y: 2}, centroid)
+
+	// 4 by 2 rectangle, inertia m(w^2 + h^2)/12
+	mass, inertia, centroid = box(t, 1, 1, 5, 3).massData(2)
+	assert.InDelta(t, 16, mass, 1e-9)
+	assert.InDelta(t, 16*20/12.0, inertia, 1e-9)
+	assert.InDelta(t, 3, centroid.X, 1e-9)
+	assert.InDelta(t, 2, centroid.Y, 1e-9)
+}
+
+// TestShapeAABB - test bounds of rotated shapes
+func TestShapeAABB(t *testing.T) {
+	disk := NewCircleShape(circle.NewCircle(1, 0, 1))
+	b := disk.aabb(Vec2{X: 10, Y: 10}, newRotation(math.Pi/2))
+	assert.InDelta(t, 9, b.Min.X, 1e-9)
+	assert.InDelta(t, 10, b.Min.Y, 1e-9)
+	assert.InDelta(t, 11, b.Max.X, 1e-9)
+	assert.InDelta(t, 12, b.Max.Y, 1e-9)
+
+	square := box(t, -1, -1, 1, 1)
+	b = square.aabb(Vec2{}, newRotation(math.Pi/4))
+	assert.InDelta(t, -math.Sqrt2, b.Min.X, 1e-9)
+	assert.InDelta(t, math.Sqrt2, b.Max.Y, 1e-9)
+}
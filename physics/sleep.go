@@ -0,0 +1,190 @@
+package physics
+
+import (
+	"math"
+	"slices"
+)
+
+// Defaults for sleeping in new worlds
+const (
+	DefaultLinearSleepTolerance  = 0.01              // speed below which a body is at rest
+	DefaultAngularSleepTolerance = 2 * math.Pi / 180 // angular speed in radians per second below which a body is at rest
+	DefaultTimeToSleep           = 0.5               // seconds an island must be at rest before it falls asleep
+)
+
+// islands - disjoint sets of awake dynamic bodies joined by contacts and joints, each kept as its root body
+type islands map[*Body]*Body
+
+// find - root of the island holding a body, halving the path to it on the way
+func (s islands) find(b *Body) *Body {
+	for s[b] != b {
+		s[b] = s[s[b]]
+		b = s[b]
+	}
+	return b
+}
+
+// union - merge the islands holding two bodies, keeping the root with the lower ID so merges do not depend on
+// the order they are made in
+func (s islands) union(a, b *Body) {
+	a, b = s.find(a), s.find(b)
+	if b.id < a.id {
+		a, b = b, a
+	}
+	s[b] = a
+}
+
+// Islands - groups of awake dynamic bodies that touch or are joined, directly or through other bodies of the
+// group. Static and kinematic bodies do not join the bodies they touch into one island. Bodies are in order of
+// ID within each island, and islands are in order of their first body's ID.
+func (w *World) Islands() [][]*Body {
+	s := w.islands()
+	index := map[*Body]int{}
+	var groups [][]*Body
+	for _, b := range w.bodies {
+		if _, ok := s[b]; !ok {
+			continue
+		}
+		root := s.find(b)
+		i, ok := index[root]
+		if !ok {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], b)
+	}
+	return groups
+}
+
+// islands - awake dynamic bodies grouped by the contacts and joints between them
+func (w *World) islands() islands {
+	s := islands{}
+	for _, b := range w.bodies {
+		if b.Type == DynamicBody && b.awake {
+			s[b] = b
+		}
+	}
+	join := func(a, b *Body) {
+		_, okA := s[a]
+		_, okB := s[b]
+		if okA && okB {
+			s.union(a, b)
+		}
+	}
+	for _, c := range w.contacts {
+		join(c.a, c.b)
+	}
+	for _, j := range w.joints {
+		join(j.base().a, j.base().b)
+	}
+	return s
+}
+
+// updateSleep - advance how long each awake dynamic body has been at rest, and put to sleep islands whose bodies
+// have all been at rest for TimeToSleep. An island touching a moving kinematic body or dragged by a mouse joint
+// stays awake.
+func (w *World) updateSleep(dt float64) {
+	if !w.AllowSleep {
+		return
+	}
+	linear, angular := w.LinearSleepTolerance*w.LinearSleepTolerance, w.AngularSleepTolerance*w.AngularSleepTolerance
+	for _, b := range w.bodies {
+		if b.Type != DynamicBody || !b.awake {
+			continue
+		}
+		if !b.AllowSleep || b.Velocity.LengthSquared() > linear || b.AngularVelocity*b.AngularVelocity > angular {
+			b.sleepTime = 0
+		} else {
+			b.sleepTime += dt
+		}
+	}
+
+	s := w.islands()
+	restless := map[*Body]bool{}
+	hold := func(a, b *Body) {
+		if a.Type == KinematicBody && a.moving() {
+			restless[b] = true
+		}
+		if b.Type == KinematicBody && b.moving() {
+			restless[a] = true
+		}
+	}
+	for _, c := range w.contacts {
+		hold(c.a, c.b)
+	}
+	for _, j := range w.joints {
+		if a, b := j.Bodies(); a == nil {
+			restless[b] = true
+		} else {
+			hold(a, b)
+		}
+	}
+	// an island is only as rested as its most recently moving body
+	rested := map[*Body]float64{}
+	for _, b := range w.bodies {
+		if _, ok := s[b]; !ok {
+			continue
+		}
+		root := s.find(b)
+		sleepTime := b.sleepTime
+		if restless[b] {
+			sleepTime = 0
+		}
+		if t, ok := rested[root]; !ok || sleepTime < t {
+			rested[root] = sleepTime
+		}
+	}
+	for _, b := range w.bodies {
+		if _, ok := s[b]; ok && rested[s.find(b)] >= w.TimeToSleep {
+			b.SetAwake(false)
+		}
+	}
+}
+
+// wake - wake sleeping bodies touched or joined to bodies that are moving, so a body only sleeps while everything
+// it meets is still
+func (w *World) wake(pairs []pair) {
+	for _, p := range pairs {
+		switch {
+		case p.a.moving() && p.b.Type == DynamicBody && !p.b.awake:
+			p.b.SetAwake(true)
+		case p.b.moving() && p.a.Type == DynamicBody && !p.a.awake:
+			p.a.SetAwake(true)
+		}
+	}
+}
+
+// wakeTouching - wake the bodies touching or joined to a body
+func (w *World) wakeTouching(b *Body) {
+	for _, c := range w.contacts {
+		if c.a == b {
+			c.b.SetAwake(true)
+		} else if c.b == b {
+			c.a.SetAwake(true)
+		}
+	}
+	for _, j := range b.joints {
+		j.base().a.SetAwake(true)
+		j.base().b.SetAwake(true)
+	}
+}
+
+// activeContacts - contacts with a body that may move, which are all the solver need consider
+func (w *World) activeContacts() []*contact {
+	return slices.DeleteFunc(slices.Clone(w.contacts), func(c *contact) bool {
+		return !c.a.moving() && !c.b.moving()
+	})
+}
+
+// activeJoints - joints with a body that may move, after waking bodies joined to them
+func (w *World) activeJoints() []Joint {
+	joined := make([]pair, len(w.joints))
+	for i, j := range w.joints {
+		joined[i] = pair{a: j.base().a, b: j.base().b}
+	}
+	w.wake(joined)
+	return slices.DeleteFunc(slices.Clone(w.joints), func(j Joint) bool {
+		return !j.base().a.moving() && !j.base().b.moving()
+	})
+}
@@ -0,0 +1,140 @@
+package physics
+
+import (
+	"collision/circle"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// settle - step the world until every dynamic body is asleep, failing if that takes more than a number of steps
+func settle(t *testing.T, w *World, steps int) {
+	t.Helper()
+	for i := 0; i < steps; i++ {
+		run(w, 1)
+		if len(w.Islands()) == 0 {
+			return
+		}
+	}
+	t.Fatalf("expect bodies asleep within %d steps", steps)
+}
+
+// TestBodiesFallAsleep - test that a stack at rest falls asleep as one island, keeps its contacts and is no longer
+// moved
+func TestBodiesFallAsleep(t *testing.T) {
+	w, _ := newTestWorld(t)
+	lower := NewBody(DynamicBody, box(t, -0.5, 0, 0.5, 1), 1)
+	upper := NewBody(DynamicBody, box(t, -0.5, 1, 0.5, 2), 1)
+	assert.Nil(t, w.AddBody(lower))
+	assert.Nil(t, w.AddBody(upper))
+	run(w, 1)
+	assert.Equal(t, [][]*Body{{lower, upper}}, w.Islands())
+	assert.True(t, upper.Awake())
+
+	// the stack cannot sleep before it has been at rest for the time to sleep
+	run(w, int(DefaultTimeToSleep/w.TimeStep)-2)
+	assert.True(t, upper.Awake())
+	settle(t, w, 60)
+	assert.False(t, lower.Awake())
+	assert.False(t, upper.Awake())
+	assert.Equal(t, Vec2{}, upper.Velocity)
+	assert.Len(t, w.Contacts(), 2)
+
+	// a sleeping body is left where it is, even if its velocity is changed directly
+	position := upper.Position
+	upper.Velocity = Vec2{X: 1, Y: 0}
+	run(w, 10)
+	assert.Equal(t, position, upper.Position)
+}
+
+// TestWakeOnForce - test that a force or impulse wakes a sleeping body and its island follows
+func TestWakeOnForce(t *testing.T) {
+	w, _ := newTestWorld(t)
+	lower := NewBody(DynamicBody, box(t, -0.5, 0, 0.5, 1), 1)
+	upper := NewBody(DynamicBody, box(t, -0.5, 1, 0.5, 2), 1)
+	assert.Nil(t, w.AddBody(lower))
+	assert.Nil(t, w.AddBody(upper))
+	settle(t, w, 120)
+
+	upper.ApplyImpulse(Vec2{X: 2, Y: 0}, upper.Position)
+	assert.True(t, upper.Awake())
+	assert.Equal(t, Vec2{X: 2 / upper.Mass(), Y: 0}, upper.Velocity)
+	run(w, 1)
+	assert.True(t, lower.Awake(), "expect body touching a moving body to wake")
+	assert.Greater(t, upper.Position.X, 0.0)
+
+	settle(t, w, 240)
+	lower.ApplyForceToCentre(Vec2{X: 0, Y: 1})
+	assert.True(t, lower.Awake())
+}
+
+// TestWakeOnContact - test that a body dropped onto a sleeping body wakes it, and that removing a sleeping body's
+// support wakes it to fall
+func TestWakeOnContact(t *testing.T) {
+	w, ground := newTestWorld(t)
+	shelf := NewBody(StaticBody, box(t, 4, 2, 6, 3), 1)
+	assert.Nil(t, w.AddBody(shelf))
+	crate := NewBody(DynamicBody, box(t, -0.5, 0, 0.5, 1), 1)
+	book := NewBody(DynamicBody, box(t, 4.5, 3, 5.5, 3.5), 1)
+	assert.Nil(t, w.AddBody(crate))
+	assert.Nil(t, w.AddBody(book))
+	settle(t, w, 120)
+
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 3, 0.5)), 1)
+	assert.Nil(t, w.AddBody(ball))
+	run(w, 45)
+	assert.True(t, crate.Awake())
+	assert.False(t, book.Awake(), "expect a body out of reach to sleep on")
+	assert.Equal(t, [][]*Body{{crate, ball}}, w.Islands())
+
+	assert.Nil(t, w.RemoveBody(shelf))
+	assert.True(t, book.Awake())
+	run(w, 60)
+	assert.Less(t, book.Position.Y, 1.0)
+	assert.Equal(t, []*Body{ground, crate, book, ball}, w.Bodies())
+}
+
+// TestIslands - test that bodies resting on the same static ground form separate islands, and joints join them
+func TestIslands(t *testing.T) {
+	w, _ := newTestWorld(t)
+	w.AllowSleep = false
+	left := NewBody(DynamicBody, box(t, -5, 0, -4, 1), 1)
+	right := NewBody(DynamicBody, box(t, 4, 0, 5, 1), 1)
+	middle := NewBody(DynamicBody, box(t, -0.5, 0, 0.5, 1), 1)
+	for _, b := range []*Body{left, right, middle} {
+		assert.Nil(t, w.AddBody(b))
+	}
+	run(w, 120)
+	assert.Equal(t, [][]*Body{{left}, {right}, {middle}}, w.Islands())
+	assert.True(t, left.Awake(), "expect nothing to sleep when the world does not allow it")
+
+	assert.Nil(t, w.AddJoint(NewDistanceJoint(middle, right, middle.Position, right.Position)))
+	assert.Equal(t, [][]*Body{{left}, {right, middle}}, w.Islands())
+}
+
+// TestNoSleep - test that bodies that may not sleep, or that are touched by a moving kinematic body or dragged by a
+// mouse joint, stay awake with their islands
+func TestNoSleep(t *testing.T) {
+	w, _ := newTestWorld(t)
+	restless := NewBody(DynamicBody, box(t, -5, 0, -4, 1), 1)
+	restless.AllowSleep = false
+	carried := NewBody(DynamicBody, box(t, 4, 1, 5, 2), 1)
+	conveyor := NewBody(KinematicBody, box(t, 2, 0, 8, 1), 1)
+	dragged := NewBody(DynamicBody, box(t, -0.5, 0, 0.5, 1), 1)
+	for _, b := range []*Body{restless, carried, conveyor, dragged} {
+		assert.Nil(t, w.AddBody(b))
+	}
+	assert.Nil(t, w.AddJoint(NewMouseJoint(dragged, Vec2{X: 0, Y: 1}, 1)))
+	conveyor.Velocity = Vec2{X: 0.001, Y: 0}
+	run(w, 120)
+	assert.True(t, restless.Awake())
+	assert.True(t, carried.Awake())
+	assert.True(t, dragged.Awake())
+
+	conveyor.Velocity = Vec2{}
+	assert.Nil(t, w.RemoveJoint(w.Joints()[0]))
+	run(w, 60)
+	assert.True(t, restless.Awake())
+	assert.False(t, carried.Awake())
+	assert.False(t, dragged.Awake())
+}
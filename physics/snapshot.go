@@ -0,0 +1,730 @@
+package physics
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+)
+
+// ErrInvalidSnapshot - returned when data to restore is not a snapshot of a world
+var ErrInvalidSnapshot = errors.New("invalid world snapshot")
+
+// snapshotHeader - bytes opening every snapshot, the last of them its format version
+var snapshotHeader = []byte{'P', 'H', 'Y', 'S', 1}
+
+// Kinds of joint in a snapshot
+const (
+	distanceJointKind uint8 = iota + 1
+	ropeJointKind
+	revoluteJointKind
+	prismaticJointKind
+	weldJointKind
+	mouseJointKind
+)
+
+// Snapshot - encode the complete state of the world: its settings, its bodies with their shapes and motion, its
+// joints, its contacts and sensor overlaps with the impulses kept for warm starting, and the time carried over
+// to the next call to Step. The broad phase keeps nothing between steps beyond the bodies' placements. Every
+// float is kept bit for bit, so a world restored from the snapshot steps exactly as the world did after it was
+// taken. Callbacks, the collision predicate and user data are not encoded.
+func (w *World) Snapshot() []byte {
+	e := &encoder{buf: append([]byte{}, snapshotHeader...)}
+	e.vec(w.Gravity)
+	e.f64(w.TimeStep)
+	e.int(w.VelocityIterations)
+	e.int(w.MaxSteps)
+	e.bool(w.WarmStarting)
+	e.bool(w.AllowSleep)
+	e.f64(w.LinearSleepTolerance)
+	e.f64(w.AngularSleepTolerance)
+	e.f64(w.TimeToSleep)
+	e.u32(uint32(w.nextID))
+	e.f64(w.accumulator)
+
+	e.u32(uint32(len(w.bodies)))
+	for _, b := range w.bodies {
+		e.body(b)
+	}
+	e.u32(uint32(len(w.joints)))
+	for _, j := range w.joints {
+		e.joint(j)
+	}
+	e.u32(uint32(len(w.contacts)))
+	for _, c := range w.contacts {
+		e.contact(c)
+	}
+	e.u32(uint32(len(w.overlaps)))
+	for _, p := range w.overlaps {
+		e.u32(uint32(p.a.id))
+		e.u32(uint32(p.b.id))
+	}
+	return e.buf
+}
+
+// Restore - replace the state of the world with a snapshot, for rolling the simulation back. Bodies still in the
+// world keep their identity and user data, taking on their state in the snapshot, and so do joints still in the
+// world between the same bodies. Bodies and joints that have been removed since the snapshot was taken are
+// recreated, and those added since are removed, without calling any callbacks. Returns ErrInvalidSnapshot, and
+// leaves the world unchanged, if data is not a snapshot.
+func (w *World) Restore(data []byte) error {
+	s, err := decodeSnapshot(data)
+	if err != nil {
+		return err
+	}
+
+	// reuse bodies still in the world, so the caller's references to them stay good
+	existing := make(map[BodyID]*Body, len(w.bodies))
+	for _, b := range w.bodies {
+		existing[b.id] = b
+	}
+	final := make(map[*Body]*Body, len(s.bodies))
+	for i, b := range s.bodies {
+		if old, ok := existing[b.id]; ok {
+			b.UserData = old.UserData
+			*old = *b
+			s.bodies[i] = old
+			delete(existing, b.id)
+		}
+		final[b] = s.bodies[i]
+		s.bodies[i].world = w
+	}
+	for _, b := range existing {
+		b.world, b.joints = nil, nil
+	}
+	resolve := func(b *Body) *Body {
+		if f, ok := final[b]; ok {
+			return f
+		}
+		return b
+	}
+
+	// reuse joints of the same kind between the same bodies, in order
+	previous := slices.Clone(w.joints)
+	for i, j := range s.joints {
+		base := j.base()
+		base.a, base.b = resolve(base.a), resolve(base.b)
+		a, b := j.Bodies()
+		for k, old := range previous {
+			if old == nil || jointKind(old) != jointKind(j) {
+				continue
+			}
+			if oldA, oldB := old.Bodies(); oldA == a && oldB == b {
+				s.joints[i] = reuseJoint(old, j)
+				previous[k] = nil
+				break
+			}
+		}
+		base = s.joints[i].base()
+		base.world = w
+		base.a.joints = append(base.a.joints, s.joints[i])
+		base.b.joints = append(base.b.joints, s.joints[i])
+	}
+	for _, old := range previous {
+		if old != nil {
+			old.base().world = nil
+		}
+	}
+	for _, c := range s.contacts {
+		c.a, c.b = resolve(c.a), resolve(c.b)
+	}
+	for i := range s.overlaps {
+		s.overlaps[i] = pair{a: resolve(s.overlaps[i].a), b: resolve(s.overlaps[i].b)}
+	}
+
+	w.Gravity, w.TimeStep = s.world.Gravity, s.world.TimeStep
+	w.VelocityIterations, w.MaxSteps = s.world.VelocityIterations, s.world.MaxSteps
+	w.WarmStarting, w.AllowSleep = s.world.WarmStarting, s.world.AllowSleep
+	w.LinearSleepTolerance, w.AngularSleepTolerance = s.world.LinearSleepTolerance, s.world.AngularSleepTolerance
+	w.TimeToSleep, w.nextID, w.accumulator = s.world.TimeToSleep, s.world.nextID, s.world.accumulator
+	w.bodies, w.joints, w.contacts, w.overlaps = s.bodies, s.joints, s.contacts, s.overlaps
+	return nil
+}
+
+// snapshot - decoded state of a world, its bodies not yet matched to those of the world it is restored into
+type snapshot struct {
+	world    World
+	bodies   []*Body
+	joints   []Joint
+	contacts []*contact
+	overlaps []pair
+}
+
+// decodeSnapshot - state encoded by Snapshot, or ErrInvalidSnapshot if data is not a snapshot
+func decodeSnapshot(data []byte) (snapshot, error) {
+	var s snapshot
+	d := &decoder{data: data}
+	for _, h := range snapshotHeader {
+		if d.u8() != h {
+			return s, ErrInvalidSnapshot
+		}
+	}
+	w := &s.world
+	w.Gravity = d.vec()
+	w.TimeStep = d.f64()
+	w.VelocityIterations = d.int()
+	w.MaxSteps = d.int()
+	w.WarmStarting = d.bool()
+	w.AllowSleep = d.bool()
+	w.LinearSleepTolerance = d.f64()
+	w.AngularSleepTolerance = d.f64()
+	w.TimeToSleep = d.f64()
+	w.nextID = BodyID(d.u32())
+	w.accumulator = d.f64()
+
+	byID := map[BodyID]*Body{}
+	s.bodies = make([]*Body, d.count(bodyMinSize))
+	for i := range s.bodies {
+		b := d.body()
+		if byID[b.id] != nil || b.id == 0 || b.id >= w.nextID || i > 0 && b.id <= s.bodies[i-1].id {
+			d.fail()
+		}
+		s.bodies[i], byID[b.id] = b, b
+	}
+	body := func() *Body {
+		b := byID[BodyID(d.u32())]
+		if b == nil {
+			d.fail()
+			return &Body{}
+		}
+		return b
+	}
+	s.joints = make([]Joint, d.count(jointMinSize))
+	for i := range s.joints {
+		s.joints[i] = d.joint(body)
+	}
+	s.contacts = make([]*contact, d.count(contactMinSize))
+	for i := range s.contacts {
+		s.contacts[i] = d.contact(body)
+	}
+	s.overlaps = make([]pair, d.count(8))
+	for i := range s.overlaps {
+		s.overlaps[i] = pair{a: body(), b: body()}
+	}
+	if d.err != nil || len(d.data) != 0 {
+		return snapshot{}, ErrInvalidSnapshot
+	}
+	return s, nil
+}
+
+// jointKind - kind of joint in a snapshot
+func jointKind(j Joint) uint8 {
+	switch j.(type) {
+	case *DistanceJoint:
+		return distanceJointKind
+	case *RopeJoint:
+		return ropeJointKind
+	case *RevoluteJoint:
+		return revoluteJointKind
+	case *PrismaticJoint:
+		return prismaticJointKind
+	case *WeldJoint:
+		return weldJointKind
+	case *MouseJoint:
+		return mouseJointKind
+	}
+	return 0
+}
+
+// reuseJoint - joint old given the state of decoded, which is of the same kind
+func reuseJoint(old, decoded Joint) Joint {
+	switch old := old.(type) {
+	case *DistanceJoint:
+		*old = *decoded.(*DistanceJoint)
+	case *RopeJoint:
+		*old = *decoded.(*RopeJoint)
+	case *RevoluteJoint:
+		*old = *decoded.(*RevoluteJoint)
+	case *PrismaticJoint:
+		*old = *decoded.(*PrismaticJoint)
+	case *WeldJoint:
+		*old = *decoded.(*WeldJoint)
+	case *MouseJoint:
+		*old = *decoded.(*MouseJoint)
+	}
+	return old
+}
+
+// Least number of bytes encoding each part of a world, for rejecting counts too large for the data
+const (
+	bodyMinSize      = 4 + 2 + 8 + 16 + 4 + 4 + 16 + 8 + 16 + 8 + 8 + 8 + 12 + 2 + 8*4 + 16 + 8 + 1 + 8
+	jointMinSize     = 1 + 4 + 4 + 16*4 + 1 + 8 + 8 + 16 + 8
+	contactMinSize   = 4 + 4 + 16 + 8 + 8 + 1 + 4
+	contactPointSize = 16 + 8 + 4 + 16 + 16 + 8*5
+)
+
+// encoder - little endian binary encoding of world state
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) u8(v uint8) {
+	e.buf = append(e.buf, v)
+}
+
+func (e *encoder) u32(v uint32) {
+	e.buf = binary.LittleEndian.AppendUint32(e.buf, v)
+}
+
+func (e *encoder) int(v int) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, uint64(int64(v)))
+}
+
+func (e *encoder) f64(v float64) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+}
+
+func (e *encoder) bool(v bool) {
+	if v {
+		e.u8(1)
+	} else {
+		e.u8(0)
+	}
+}
+
+func (e *encoder) vec(v Vec2) {
+	e.f64(v.X)
+	e.f64(v.Y)
+}
+
+func (e *encoder) vecs(vs []Vec2) {
+	e.u32(uint32(len(vs)))
+	for _, v := range vs {
+		e.vec(v)
+	}
+}
+
+func (e *encoder) mat(m mat22) {
+	e.f64(m.a)
+	e.f64(m.b)
+	e.f64(m.c)
+	e.f64(m.d)
+}
+
+// body - encode a body, other than its world, joints and user data
+func (e *encoder) body(b *Body) {
+	e.u32(uint32(b.id))
+	e.u8(uint8(b.Type))
+	e.u8(uint8(b.shape.kind))
+	e.f64(b.shape.radius)
+	e.vec(b.shape.centre)
+	e.vecs(b.shape.vertices)
+	e.vecs(b.shape.normals)
+	e.vec(b.Position)
+	e.f64(b.Angle)
+	e.vec(b.Velocity)
+	e.f64(b.AngularVelocity)
+	e.f64(b.Restitution)
+	e.f64(b.Friction)
+	e.u32(b.Filter.Category)
+	e.u32(b.Filter.Mask)
+	e.u32(uint32(b.Filter.Group))
+	e.bool(b.Sensor)
+	e.bool(b.AllowSleep)
+	e.f64(b.mass)
+	e.f64(b.inertia)
+	e.f64(b.invMass)
+	e.f64(b.invInertia)
+	e.vec(b.force)
+	e.f64(b.torque)
+	e.bool(b.awake)
+	e.f64(b.sleepTime)
+}
+
+// joint - encode a joint, its bodies by ID
+func (e *encoder) joint(j Joint) {
+	base := j.base()
+	e.u8(jointKind(j))
+	if a, _ := j.Bodies(); a != nil {
+		e.u32(uint32(a.id))
+	} else {
+		e.u32(0)
+	}
+	e.u32(uint32(base.b.id))
+	e.vec(base.localA)
+	e.vec(base.localB)
+	e.vec(base.rA)
+	e.vec(base.rB)
+	e.bool(base.CollideConnected)
+	e.f64(base.BreakForce)
+	e.f64(base.BreakTorque)
+	e.vec(base.force)
+	e.f64(base.torque)
+	switch j := j.(type) {
+	case *DistanceJoint:
+		e.f64(j.Length)
+		e.vec(j.axis)
+		e.f64(j.mass)
+		e.f64(j.bias)
+		e.f64(j.impulse)
+	case *RopeJoint:
+		e.f64(j.MaxLength)
+		e.vec(j.axis)
+		e.f64(j.mass)
+		e.f64(j.bias)
+		e.f64(j.impulse)
+	case *RevoluteJoint:
+		e.bool(j.EnableLimit)
+		e.f64(j.LowerAngle)
+		e.f64(j.UpperAngle)
+		e.bool(j.EnableMotor)
+		e.f64(j.MotorSpeed)
+		e.f64(j.MaxMotorTorque)
+		e.f64(j.referenceAngle)
+		e.point(j.point)
+		e.f64(j.mass)
+		e.f64(j.lowerBias)
+		e.f64(j.upperBias)
+		e.f64(j.maxMotorImpulse)
+		e.f64(j.motorImpulse)
+		e.f64(j.lowerImpulse)
+		e.f64(j.upperImpulse)
+	case *PrismaticJoint:
+		e.bool(j.EnableLimit)
+		e.f64(j.LowerTranslation)
+		e.f64(j.UpperTranslation)
+		e.bool(j.EnableMotor)
+		e.f64(j.MotorSpeed)
+		e.f64(j.MaxMotorForce)
+		e.vec(j.localAxis)
+		e.f64(j.referenceAngle)
+		e.vec(j.axis)
+		e.vec(j.perp)
+		e.f64(j.axialArmA)
+		e.f64(j.axialArmB)
+		e.f64(j.perpArmA)
+		e.f64(j.perpArmB)
+		e.f64(j.axialMass)
+		e.mat(j.mass)
+		e.vec(j.bias)
+		e.f64(j.lowerBias)
+		e.f64(j.upperBias)
+		e.f64(j.maxMotorImpulse)
+		e.vec(j.impulse)
+		e.f64(j.motorImpulse)
+		e.f64(j.lowerImpulse)
+		e.f64(j.upperImpulse)
+	case *WeldJoint:
+		e.f64(j.referenceAngle)
+		e.point(j.point)
+		e.f64(j.mass)
+		e.f64(j.bias)
+		e.f64(j.angularImpulse)
+	case *MouseJoint:
+		e.vec(j.a.Position)
+		e.vec(j.Target)
+		e.f64(j.MaxForce)
+		e.f64(j.Frequency)
+		e.f64(j.DampingRatio)
+		e.mat(j.mass)
+		e.vec(j.bias)
+		e.f64(j.gamma)
+		e.f64(j.maxImpulse)
+		e.vec(j.impulse)
+	}
+}
+
+func (e *encoder) point(p pointConstraint) {
+	e.mat(p.mass)
+	e.vec(p.bias)
+	e.vec(p.impulse)
+}
+
+// contact - encode a contact, its bodies by ID
+func (e *encoder) contact(c *contact) {
+	e.u32(uint32(c.a.id))
+	e.u32(uint32(c.b.id))
+	e.vec(c.normal)
+	e.f64(c.friction)
+	e.f64(c.restitution)
+	e.bool(c.persisted)
+	e.u32(uint32(len(c.points)))
+	for _, cp := range c.points {
+		e.vec(cp.position)
+		e.f64(cp.separation)
+		e.u32(cp.id)
+		e.vec(cp.rA)
+		e.vec(cp.rB)
+		e.f64(cp.normalImpulse)
+		e.f64(cp.tangentImpulse)
+		e.f64(cp.normalMass)
+		e.f64(cp.tangentMass)
+		e.f64(cp.bias)
+	}
+}
+
+// decoder - reader of data made by encoder, which records the first failure and reads zeros after it
+type decoder struct {
+	data []byte
+	err  error
+}
+
+// fail - record that the data is invalid
+func (d *decoder) fail() {
+	if d.err == nil {
+		d.err = ErrInvalidSnapshot
+	}
+	d.data = nil
+}
+
+// take - next n bytes, or nil if there are fewer left
+func (d *decoder) take(n int) []byte {
+	if d.err != nil || len(d.data) < n {
+		d.fail()
+		return nil
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *decoder) u8() uint8 {
+	if b := d.take(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) u32() uint32 {
+	if b := d.take(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (d *decoder) int() int {
+	if b := d.take(8); b != nil {
+		return int(int64(binary.LittleEndian.Uint64(b)))
+	}
+	return 0
+}
+
+func (d *decoder) f64() float64 {
+	if b := d.take(8); b != nil {
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *decoder) bool() bool {
+	switch d.u8() {
+	case 0:
+		return false
+	case 1:
+		return true
+	}
+	d.fail()
+	return false
+}
+
+func (d *decoder) vec() Vec2 {
+	return Vec2{X: d.f64(), Y: d.f64()}
+}
+
+// count - number of items to follow, each at least minSize bytes, failing if the data is too short to hold them
+func (d *decoder) count(minSize int) int {
+	n := int(d.u32())
+	if n > len(d.data)/minSize {
+		d.fail()
+		return 0
+	}
+	return n
+}
+
+func (d *decoder) vecs() []Vec2 {
+	vs := make([]Vec2, d.count(16))
+	for i := range vs {
+		vs[i] = d.vec()
+	}
+	return vs
+}
+
+func (d *decoder) mat() mat22 {
+	return mat22{a: d.f64(), b: d.f64(), c: d.f64(), d: d.f64()}
+}
+
+// body - decode a body encoded by encoder.body
+func (d *decoder) body() *Body {
+	b := &Body{}
+	b.id = BodyID(d.u32())
+	b.Type = BodyType(d.u8())
+	b.shape.kind = ShapeKind(d.u8())
+	b.shape.radius = d.f64()
+	b.shape.centre = d.vec()
+	b.shape.vertices = d.vecs()
+	b.shape.normals = d.vecs()
+	switch {
+	case b.Type > KinematicBody:
+		d.fail()
+	case b.shape.kind == ShapeCircle && (len(b.shape.vertices) != 0 || len(b.shape.normals) != 0):
+		d.fail()
+	case b.shape.kind == ShapePolygon && (len(b.shape.vertices) < 3 || len(b.shape.normals) != len(b.shape.vertices)):
+		d.fail()
+	case b.shape.kind > ShapePolygon:
+		d.fail()
+	}
+	if b.shape.kind == ShapeCircle {
+		b.shape.vertices, b.shape.normals = nil, nil
+	}
+	b.Position = d.vec()
+	b.Angle = d.f64()
+	b.Velocity = d.vec()
+	b.AngularVelocity = d.f64()
+	b.Restitution = d.f64()
+	b.Friction = d.f64()
+	b.Filter.Category = d.u32()
+	b.Filter.Mask = d.u32()
+	b.Filter.Group = int32(d.u32())
+	b.Sensor = d.bool()
+	b.AllowSleep = d.bool()
+	b.mass = d.f64()
+	b.inertia = d.f64()
+	b.invMass = d.f64()
+	b.invInertia = d.f64()
+	b.force = d.vec()
+	b.torque = d.f64()
+	b.awake = d.bool()
+	b.sleepTime = d.f64()
+	return b
+}
+
+// joint - decode a joint encoded by encoder.joint, finding its bodies with body
+func (d *decoder) joint(body func() *Body) Joint {
+	kind := d.u8()
+	var base jointBase
+	if kind == mouseJointKind {
+		if d.u32() != 0 {
+			d.fail()
+		}
+		base.a = &Body{Type: StaticBody}
+	} else {
+		base.a = body()
+	}
+	base.b = body()
+	base.localA = d.vec()
+	base.localB = d.vec()
+	base.rA = d.vec()
+	base.rB = d.vec()
+	base.CollideConnected = d.bool()
+	base.BreakForce = d.f64()
+	base.BreakTorque = d.f64()
+	base.force = d.vec()
+	base.torque = d.f64()
+	switch kind {
+	case distanceJointKind:
+		j := &DistanceJoint{jointBase: base}
+		j.Length = d.f64()
+		j.axis = d.vec()
+		j.mass = d.f64()
+		j.bias = d.f64()
+		j.impulse = d.f64()
+		return j
+	case ropeJointKind:
+		j := &RopeJoint{jointBase: base}
+		j.MaxLength = d.f64()
+		j.axis = d.vec()
+		j.mass = d.f64()
+		j.bias = d.f64()
+		j.impulse = d.f64()
+		return j
+	case revoluteJointKind:
+		j := &RevoluteJoint{jointBase: base}
+		j.EnableLimit = d.bool()
+		j.LowerAngle = d.f64()
+		j.UpperAngle = d.f64()
+		j.EnableMotor = d.bool()
+		j.MotorSpeed = d.f64()
+		j.MaxMotorTorque = d.f64()
+		j.referenceAngle = d.f64()
+		j.point = d.point()
+		j.mass = d.f64()
+		j.lowerBias = d.f64()
+		j.upperBias = d.f64()
+		j.maxMotorImpulse = d.f64()
+		j.motorImpulse = d.f64()
+		j.lowerImpulse = d.f64()
+		j.upperImpulse = d.f64()
+		return j
+	case prismaticJointKind:
+		j := &PrismaticJoint{jointBase: base}
+		j.EnableLimit = d.bool()
+		j.LowerTranslation = d.f64()
+		j.UpperTranslation = d.f64()
+		j.EnableMotor = d.bool()
+		j.MotorSpeed = d.f64()
+		j.MaxMotorForce = d.f64()
+		j.localAxis = d.vec()
+		j.referenceAngle = d.f64()
+		j.axis = d.vec()
+		j.perp = d.vec()
+		j.axialArmA = d.f64()
+		j.axialArmB = d.f64()
+		j.perpArmA = d.f64()
+		j.perpArmB = d.f64()
+		j.axialMass = d.f64()
+		j.mass = d.mat()
+		j.bias = d.vec()
+		j.lowerBias = d.f64()
+		j.upperBias = d.f64()
+		j.maxMotorImpulse = d.f64()
+		j.impulse = d.vec()
+		j.motorImpulse = d.f64()
+		j.lowerImpulse = d.f64()
+		j.upperImpulse = d.f64()
+		return j
+	case weldJointKind:
+		j := &WeldJoint{jointBase: base}
+		j.referenceAngle = d.f64()
+		j.point = d.point()
+		j.mass = d.f64()
+		j.bias = d.f64()
+		j.angularImpulse = d.f64()
+		return j
+	case mouseJointKind:
+		j := &MouseJoint{jointBase: base}
+		j.a.Position = d.vec()
+		j.Target = d.vec()
+		j.MaxForce = d.f64()
+		j.Frequency = d.f64()
+		j.DampingRatio = d.f64()
+		j.mass = d.mat()
+		j.bias = d.vec()
+		j.gamma = d.f64()
+		j.maxImpulse = d.f64()
+		j.impulse = d.vec()
+		return j
+	}
+	d.fail()
+	return &WeldJoint{jointBase: base}
+}
+
+func (d *decoder) point() pointConstraint {
+	return pointConstraint{mass: d.mat(), bias: d.vec(), impulse: d.vec()}
+}
+
+// contact - decode a contact encoded by encoder.contact, finding its bodies with body
+func (d *decoder) contact(body func() *Body) *contact {
+	c := &contact{a: body(), b: body()}
+	if c.a.id >= c.b.id {
+		d.fail()
+	}
+	c.normal = d.vec()
+	c.friction = d.f64()
+	c.restitution = d.f64()
+	c.persisted = d.bool()
+	c.points = make([]contactPoint, d.count(contactPointSize))
+	for i := range c.points {
+		cp := &c.points[i]
+		cp.position = d.vec()
+		cp.separation = d.f64()
+		cp.id = d.u32()
+		cp.rA = d.vec()
+		cp.rB = d.vec()
+		cp.normalImpulse = d.f64()
+		cp.tangentImpulse = d.f64()
+		cp.normalMass = d.f64()
+		cp.tangentMass = d.f64()
+		cp.bias = d.f64()
+	}
+	return c
+}
@@ -0,0 +1,141 @@
+package physics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBusyWorld - world with a stack, a bouncing ball, a sensor and one joint of each kind, some of it asleep
+func newBusyWorld(t *testing.T) *World {
+	w, ground := newTestWorld(t)
+	for i := 0; i < 4; i++ {
+		y := float32(i)
+		assert.Nil(t, w.AddBody(NewBody(DynamicBody, box(t, -6.5, y, -5.5, y+1), 1)))
+	}
+	ball := newBall(t, w, 0, 4, 0.5)
+	ball.Restitution, ball.Velocity, ball.AngularVelocity = 0.7, Vec2{X: 1, Y: 0}, 2
+	zone := NewBody(KinematicBody, box(t, -1, 0, 3, 2), 1)
+	zone.Sensor, zone.Velocity = true, Vec2{X: 0.1, Y: 0}
+	assert.Nil(t, w.AddBody(zone))
+	resting := NewBody(DynamicBody, box(t, 15, 0, 16, 1), 1)
+	assert.Nil(t, w.AddBody(resting))
+
+	bob := newBall(t, w, 8, 6, 0.25)
+	link := newBall(t, w, 10, 6, 0.25)
+	door := NewBody(DynamicBody, box(t, 4, 8, 6, 8.5), 1)
+	slider := NewBody(DynamicBody, box(t, -10, 3, -9, 4), 1)
+	beam := NewBody(DynamicBody, box(t, 12, 5, 14, 5.5), 1)
+	crate := NewBody(DynamicBody, box(t, -14, 0, -13, 1), 1)
+	for _, b := range []*Body{door, slider, beam, crate} {
+		assert.Nil(t, w.AddBody(b))
+	}
+	revolute := NewRevoluteJoint(ground, door, Vec2{X: 4, Y: 8.25})
+	revolute.EnableLimit, revolute.LowerAngle, revolute.UpperAngle = true, -1, 0.5
+	revolute.EnableMotor, revolute.MotorSpeed, revolute.MaxMotorTorque = true, -1, 2
+	prismatic := NewPrismaticJoint(ground, slider, slider.Position, Vec2{X: 1, Y: 2})
+	prismatic.EnableLimit, prismatic.LowerTranslation, prismatic.UpperTranslation = true, -2, 0
+	weld := NewWeldJoint(ground, beam, Vec2{X: 12, Y: 5.25})
+	weld.BreakTorque = 1e6
+	mouse := NewMouseJoint(crate, crate.Position, 500)
+	mouse.Target = Vec2{X: -12, Y: 3}
+	for _, j := range []Joint{
+		NewDistanceJoint(ground, bob, Vec2{X: 8, Y: 9}, bob.Position),
+		NewRopeJoint(bob, link, bob.Position, link.Position, 2.5),
+		revolute, prismatic, weld, mouse,
+	} {
+		assert.Nil(t, w.AddJoint(j))
+	}
+	return w
+}
+
+// TestSnapshotRestore - test that a world restored from a snapshot steps bit for bit as it did after the
+// snapshot was taken, whether restored into the same world or a new one
+func TestSnapshotRestore(t *testing.T) {
+	w := newBusyWorld(t)
+	run(w, 50)
+	w.Step(w.TimeStep / 3)
+	snapshot := w.Snapshot()
+	run(w, 100)
+	expected := w.Snapshot()
+	assert.NotEqual(t, snapshot, expected)
+
+	assert.Nil(t, w.Restore(snapshot))
+	assert.Equal(t, snapshot, w.Snapshot())
+	run(w, 100)
+	assert.Equal(t, expected, w.Snapshot())
+
+	fresh := NewWorld(Vec2{})
+	assert.Nil(t, fresh.Restore(snapshot))
+	run(fresh, 100)
+	assert.Equal(t, expected, fresh.Snapshot())
+	assert.Len(t, fresh.Joints(), 6)
+	assert.Equal(t, len(w.Contacts()), len(fresh.Contacts()))
+}
+
+// TestRestoreKeepsIdentity - test that bodies and joints still in the world keep their identity across a restore,
+// while those removed since the snapshot are recreated and those added since are removed
+func TestRestoreKeepsIdentity(t *testing.T) {
+	w, ground := newTestWorld(t)
+	ground.UserData = "ground"
+	ball := newBall(t, w, 0, 0.5, 0.5)
+	pivot := newBall(t, w, 5, 5, 0.5)
+	joint := NewDistanceJoint(ground, pivot, Vec2{X: 5, Y: 8}, pivot.Position)
+	assert.Nil(t, w.AddJoint(joint))
+	run(w, 10)
+	snapshot := w.Snapshot()
+
+	assert.Nil(t, w.RemoveBody(ball))
+	extra := newBall(t, w, -5, 5, 0.5)
+	rope := NewRopeJoint(ground, extra, Vec2{X: -5, Y: 8}, extra.Position, 3)
+	assert.Nil(t, w.AddJoint(rope))
+	joint.Length = 1
+	run(w, 10)
+
+	var exited int
+	w.OnEnd = func(Contact) { exited++ }
+	assert.Nil(t, w.Restore(snapshot))
+	assert.Zero(t, exited, "expect no callbacks while restoring")
+	bodies := w.Bodies()
+	assert.Len(t, bodies, 3)
+	assert.Same(t, ground, bodies[0])
+	assert.Equal(t, "ground", ground.UserData)
+	assert.NotSame(t, ball, bodies[1], "expect removed body recreated")
+	assert.Equal(t, ball.ID(), bodies[1].ID())
+	assert.Same(t, pivot, bodies[2])
+	assert.ErrorIs(t, w.RemoveBody(extra), ErrBodyNotInWorld)
+	assert.Equal(t, []Joint{joint}, w.Joints(), "expect joint between the same bodies reused")
+	assert.InDelta(t, 3, joint.Length, 1e-9)
+	assert.ErrorIs(t, w.RemoveJoint(rope), ErrJointNotInWorld)
+	assert.Equal(t, snapshot, w.Snapshot())
+	assert.Equal(t, bodies[1], w.Contacts()[0].BodyB)
+
+	// a new body takes the next ID after those in the snapshot
+	assert.Nil(t, w.AddBody(extra))
+	assert.Equal(t, BodyID(4), extra.ID())
+}
+
+// TestRestoreInvalid - test that data that is not a whole snapshot is rejected without changing the world
+func TestRestoreInvalid(t *testing.T) {
+	w := newBusyWorld(t)
+	run(w, 20)
+	snapshot := w.Snapshot()
+	run(w, 5)
+	before := w.Snapshot()
+
+	for _, data := range [][]byte{
+		nil,
+		snapshot[:len(snapshot)-1],
+		append(append([]byte{}, snapshot...), 0),
+		append([]byte{'P', 'H', 'Y', 'S', 2}, snapshot[5:]...),
+	} {
+		assert.ErrorIs(t, w.Restore(data), ErrInvalidSnapshot)
+	}
+	assert.Equal(t, before, w.Snapshot())
+
+	// a count larger than the data could hold is rejected before anything is allocated for it
+	empty := NewWorld(Vec2{}).Snapshot()
+	empty[len(empty)-16] = 0xFF
+	assert.ErrorIs(t, w.Restore(empty), ErrInvalidSnapshot)
+	assert.Equal(t, before, w.Snapshot())
+}
@@ -0,0 +1,41 @@
+package physics
+
+import (
+	"collision/internal/vec"
+	"collision/point"
+	"math"
+)
+
+// Vec2 - two dimensional vector in double precision, used for body state so that simulation error does not
+// build up at float32 precision
+type Vec2 = vec.Vec
+
+// VecFromPoint - point as a vector
+func VecFromPoint(p point.Point) Vec2 {
+	return vec.FromPoint(p)
+}
+
+// crossScalar - cross product of angular velocity s with vector v, the linear velocity of a point at v
+func crossScalar(s float64, v Vec2) Vec2 {
+	return Vec2{X: -s * v.Y, Y: s * v.X}
+}
+
+// rotation - cosine and sine of an angle, for rotating vectors from body to world coordinates
+type rotation struct {
+	c, s float64
+}
+
+// newRotation - rotation by angle in radians
+func newRotation(angle float64) rotation {
+	return rotation{c: math.Cos(angle), s: math.Sin(angle)}
+}
+
+// apply - vector rotated from body to world coordinates
+func (r rotation) apply(v Vec2) Vec2 {
+	return Vec2{X: r.c*v.X - r.s*v.Y, Y: r.s*v.X + r.c*v.Y}
+}
+
+// inverse - vector rotated from world to body coordinates
+func (r rotation) inverse(v Vec2) Vec2 {
+	return Vec2{X: r.c*v.X + r.s*v.Y, Y: -r.s*v.X + r.c*v.Y}
+}
@@ -0,0 +1,43 @@
+package physics
+
+// WeldJoint - joint fixing two bodies together at an anchor so they neither move nor turn relative to each other
+type WeldJoint struct {
+	jointBase
+	referenceAngle float64 // angle of the second body relative to the first, held by the joint
+	point          pointConstraint
+	mass           float64 // effective mass against relative rotation
+	bias           float64 // speed correcting relative rotation
+	angularImpulse float64 // impulse accumulated against rotation, kept between steps for warm starting
+}
+
+// NewWeldJoint - returns a pointer to a joint fixing the bodies together in their current placement at an anchor
+// given in world coordinates
+func NewWeldJoint(a, b *Body, anchor Vec2) *WeldJoint {
+	return &WeldJoint{jointBase: newJointBase(a, b, anchor, anchor), referenceAngle: b.Angle - a.Angle}
+}
+
+// preStep - compute effective masses and bias speeds, and apply the impulses carried over from the previous
+// step if warm starting
+func (j *WeldJoint) preStep(invDt float64, warmStart bool) {
+	j.anchors()
+	j.mass = j.angularMass()
+	j.bias = baumgarte * invDt * (j.b.Angle - j.a.Angle - j.referenceAngle)
+	if !warmStart {
+		j.angularImpulse = 0
+	}
+	j.applyAngular(j.angularImpulse)
+	j.point.prepare(&j.jointBase, invDt, warmStart)
+}
+
+// solve - one pass of sequential impulses against rotation and then on the anchors
+func (j *WeldJoint) solve() {
+	lambda := -j.mass * (j.b.AngularVelocity - j.a.AngularVelocity + j.bias)
+	j.angularImpulse += lambda
+	j.applyAngular(lambda)
+	j.point.solve(&j.jointBase)
+}
+
+// impulses - impulses applied to the second body in the current step
+func (j *WeldJoint) impulses() (Vec2, float64) {
+	return j.point.impulse, j.angularImpulse
+}
@@ -0,0 +1,23 @@
+package physics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWeldJoint - test that a beam welded to a wall holds its placement under its own weight, with the wall
+// taking its weight and the torque of it
+func TestWeldJoint(t *testing.T) {
+	w, ground := newTestWorld(t)
+	beam := NewBody(DynamicBody, box(t, 0, 5, 4, 5.5), 1)
+	assert.Nil(t, w.AddBody(beam))
+	start := beam.Position
+	j := NewWeldJoint(ground, beam, Vec2{X: 0, Y: 5.25})
+	assert.Nil(t, w.AddJoint(j))
+	run(w, 120)
+	assert.InDelta(t, 0, beam.Position.Sub(start).Length(), 0.01)
+	assert.InDelta(t, 0, beam.Angle, 0.01)
+	assert.InDelta(t, beam.Mass()*10, j.ReactionForce().Y, 0.01*beam.Mass())
+	assert.Greater(t, j.ReactionTorque(), 0.0, "expect the weld to hold the beam up against turning")
+}
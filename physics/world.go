@@ -0,0 +1,246 @@
+// Package physics provides a rigid-body simulation over the module's shapes. Bodies with circle or convex
+// polygon shapes are stepped at a fixed timestep: a sweep-and-prune broad phase finds bodies whose boxes
+// overlap, a narrow phase builds contact manifolds for those that touch, and contacts are resolved with
+// sequential impulses warm started from the previous step. Joints between bodies are solved by the same
+// iterations as contacts, and break when pushed beyond their limits. Islands of bodies that come to rest fall
+// asleep, and cost nothing to step until something wakes them.
+package physics
+
+import (
+	"errors"
+	"slices"
+)
+
+// Defaults for new worlds
+const (
+	DefaultTimeStep           = 1.0 / 60 // seconds simulated by one fixed step
+	DefaultVelocityIterations = 8        // passes of the constraint solver in each step
+	DefaultMaxSteps           = 8        // most fixed steps taken by one call to Step
+)
+
+var (
+	ErrBodyInWorld    = errors.New("body already belongs to a world")
+	ErrBodyNotInWorld = errors.New("body does not belong to this world")
+)
+
+// World - bodies simulated together, and the contacts between them
+type World struct {
+	Gravity               Vec2               // acceleration applied to dynamic bodies
+	TimeStep              float64            // seconds simulated by one fixed step
+	VelocityIterations    int                // passes of the constraint solver over joints and contacts in each step
+	MaxSteps              int                // most fixed steps taken by one call to Step, time beyond that is dropped
+	WarmStarting          bool               // start each step's solver from the impulses found in the previous step
+	OnBegin               ContactCallback    // called when two bodies start touching
+	OnStay                ContactCallback    // called in each later step the two bodies are still touching
+	OnEnd                 ContactCallback    // called when two bodies stop touching, with the contact as last seen
+	ShouldCollide         CollisionPredicate // custom rule, called for pairs whose filters allow them to collide
+	OnSensorEnter         SensorCallback     // called when a body starts to overlap a sensor
+	OnSensorExit          SensorCallback     // called when a body stops overlapping a sensor
+	OnJointBreak          JointBreakCallback // called when a joint breaks and is removed from the world
+	AllowSleep            bool               // let islands of bodies at rest fall asleep
+	LinearSleepTolerance  float64            // speed below which a body is at rest
+	AngularSleepTolerance float64            // angular speed in radians per second below which a body is at rest
+	TimeToSleep           float64            // seconds an island must be at rest before it falls asleep
+	bodies                []*Body            // in order of ID
+	nextID                BodyID
+	joints                []Joint    // in order of addition
+	contacts              []*contact // in order of pair key
+	overlaps              []pair     // sensors and the bodies overlapping them, in order of pair key
+	accumulator           float64    // time passed to Step and not yet simulated
+}
+
+// NewWorld - returns a pointer to an empty world with gravity and default settings
+func NewWorld(gravity Vec2) *World {
+	return &World{
+		Gravity:               gravity,
+		TimeStep:              DefaultTimeStep,
+		VelocityIterations:    DefaultVelocityIterations,
+		MaxSteps:              DefaultMaxSteps,
+		WarmStarting:          true,
+		AllowSleep:            true,
+		LinearSleepTolerance:  DefaultLinearSleepTolerance,
+		AngularSleepTolerance: DefaultAngularSleepTolerance,
+		TimeToSleep:           DefaultTimeToSleep,
+		nextID:                1,
+	}
+}
+
+// AddBody - add a body to the world, assigning its ID. Returns ErrBodyInWorld if the body already belongs to a
+// world.
+func (w *World) AddBody(b *Body) error {
+	if b.world != nil {
+		return ErrBodyInWorld
+	}
+	b.world, b.id = w, w.nextID
+	w.nextID++
+	w.bodies = append(w.bodies, b)
+	return nil
+}
+
+// RemoveBody - remove a body, its joints and its contacts from the world, waking the bodies it touched and
+// calling OnEnd for each of its contacts and OnSensorExit for each sensor overlap. Returns ErrBodyNotInWorld if it
+// belongs to another world or none.
+func (w *World) RemoveBody(b *Body) error {
+	if b.world != w {
+		return ErrBodyNotInWorld
+	}
+	w.wakeTouching(b)
+	for _, j := range append([]Joint{}, b.joints...) {
+		_ = w.RemoveJoint(j)
+	}
+	var ended []*contact
+	w.bodies = slices.DeleteFunc(w.bodies, func(other *Body) bool { return other == b })
+	w.contacts = slices.DeleteFunc(w.contacts, func(c *contact) bool {
+		if c.a == b || c.b == b {
+			ended = append(ended, c)
+			return true
+		}
+		return false
+	})
+	var exited []pair
+	w.overlaps = slices.DeleteFunc(w.overlaps, func(p pair) bool {
+		if p.a == b || p.b == b {
+			exited = append(exited, p)
+			return true
+		}
+		return false
+	})
+	b.world = nil
+	append(w.contactEvents(ended, nil), w.sensorEvents(exited, nil)...).fire()
+	return nil
+}
+
+// Bodies - bodies in the world, in order of ID
+func (w *World) Bodies() []*Body {
+	return append([]*Body{}, w.bodies...)
+}
+
+// Contacts - pairs of bodies touching at the end of the last step, in order of their IDs
+func (w *World) Contacts() []Contact {
+	contacts := make([]Contact, len(w.contacts))
+	for i, c := range w.contacts {
+		contacts[i] = c.public()
+	}
+	return contacts
+}
+
+// Step - advance the world by elapsed seconds in fixed steps of TimeStep. Time left over is carried to the
+// next call, so the simulation is the same however elapsed time is divided between calls. Elapsed time that is
+// not positive is ignored, and a TimeStep or MaxSteps that is not positive is taken as its default. Returns the
+// number of fixed steps taken.
+func (w *World) Step(elapsed float64) int {
+	if elapsed > 0 {
+		w.accumulator += elapsed
+	}
+	dt, maxSteps := w.TimeStep, w.MaxSteps
+	if !(dt > 0) {
+		dt = DefaultTimeStep
+	}
+	if maxSteps <= 0 {
+		maxSteps = DefaultMaxSteps
+	}
+	steps := 0
+	for w.accumulator >= dt {
+		w.accumulator -= dt
+		if steps == maxSteps {
+			// the simulation cannot keep up, so let it run slow rather than fall further behind
+			w.accumulator = 0
+			break
+		}
+		w.step(dt)
+		steps++
+	}
+	return steps
+}
+
+// step - advance the world by one fixed step of dt seconds. Sleeping bodies are left where they are, and only
+// constraints on bodies that may move are solved.
+func (w *World) step(dt float64) {
+	ended, sensed := w.updateContacts()
+	joints, contacts := w.activeJoints(), w.activeContacts()
+	for _, b := range w.bodies {
+		if b.Type != DynamicBody || !b.awake {
+			continue
+		}
+		b.Velocity = b.Velocity.Add(w.Gravity.Add(b.force.Scale(b.invMass)).Scale(dt))
+		b.AngularVelocity += b.invInertia * b.torque * dt
+	}
+	for _, j := range joints {
+		j.preStep(1/dt, w.WarmStarting)
+	}
+	for _, c := range contacts {
+		c.preStep(1/dt, w.WarmStarting)
+	}
+	for i := 0; i < w.VelocityIterations; i++ {
+		for _, j := range joints {
+			j.solve()
+		}
+		for _, c := range contacts {
+			c.solve()
+		}
+	}
+	broken := w.breakJoints(dt)
+	for _, b := range w.bodies {
+		if b.moving() {
+			b.Position = b.Position.Add(b.Velocity.Scale(dt))
+			b.Angle += b.AngularVelocity * dt
+		}
+		b.clearForces()
+	}
+	w.updateSleep(dt)
+	append(append(w.contactEvents(ended, contacts), sensed...), broken...).fire()
+}
+
+// updateContacts - replace contacts with those between bodies touching now, carrying impulses over from
+// contacts that persist, and sensor overlaps with those overlapping now. Pairs of bodies that cannot have moved
+// since the last step keep their contact or overlap without their shapes being compared again, and sleeping
+// bodies touched by moving ones are woken. Returns the contacts between bodies no longer touching, and the
+// sensor events to fire at the end of the step.
+func (w *World) updateContacts() ([]*contact, events) {
+	previous := make(map[pairKey]*contact, len(w.contacts))
+	for _, c := range w.contacts {
+		previous[c.key()] = c
+	}
+	overlapped := make(map[pairKey]bool, len(w.overlaps))
+	for _, p := range w.overlaps {
+		overlapped[p.key()] = true
+	}
+	pairs := sweepAndPrune(w.bodies, w.shouldCollide)
+	contacts := make([]*contact, 0, len(pairs))
+	var overlaps []pair
+	for _, p := range pairs {
+		sensor := p.a.Sensor || p.b.Sensor
+		if p.a.resting() && p.b.resting() {
+			if old, found := previous[p.key()]; found {
+				contacts = append(contacts, old)
+				delete(previous, p.key())
+			} else if sensor && overlapped[p.key()] {
+				overlaps = append(overlaps, p)
+			}
+			continue
+		}
+		m, ok := collide(p.a.shape, bodyTransform(p.a), p.b.shape, bodyTransform(p.b))
+		if !ok {
+			continue
+		}
+		if sensor {
+			overlaps = append(overlaps, p)
+			continue
+		}
+		w.wake([]pair{p})
+		c := newContact(p, m)
+		if old, found := previous[p.key()]; found {
+			c.inherit(old)
+		}
+		contacts = append(contacts, c)
+		delete(previous, p.key())
+	}
+	var ended []*contact
+	for _, c := range w.contacts {
+		if _, found := previous[c.key()]; found {
+			ended = append(ended, c)
+		}
+	}
+	w.contacts = contacts
+	return ended, w.sensorEvents(w.updateOverlaps(overlaps))
+}
@@ -0,0 +1,228 @@
+package physics
+
+import (
+	"collision/circle"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestWorld - world with downward gravity and a static ground box whose top is at y = 0
+func newTestWorld(t *testing.T) (*World, *Body) {
+	w := NewWorld(Vec2{X: 0, Y: -10})
+	ground := NewBody(StaticBody, box(t, -20, -1, 20, 0), 1)
+	assert.Nil(t, w.AddBody(ground))
+	return w, ground
+}
+
+// run - step the world by a number of fixed steps
+func run(w *World, steps int) {
+	for i := 0; i < steps; i++ {
+		w.Step(w.TimeStep)
+	}
+}
+
+// TestWorldAddRemoveBody - test that IDs are assigned in order and bodies belong to one world at a time
+func TestWorldAddRemoveBody(t *testing.T) {
+	w, ground := newTestWorld(t)
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0.5, 0.5)), 1)
+	assert.Equal(t, BodyID(0), ball.ID())
+	assert.Nil(t, w.AddBody(ball))
+	assert.Equal(t, BodyID(1), ground.ID())
+	assert.Equal(t, BodyID(2), ball.ID())
+	assert.ErrorIs(t, w.AddBody(ball), ErrBodyInWorld)
+	assert.ErrorIs(t, NewWorld(Vec2{}).AddBody(ball), ErrBodyInWorld)
+
+	run(w, 1)
+	assert.Len(t, w.Contacts(), 1)
+	assert.Nil(t, w.RemoveBody(ball))
+	assert.Empty(t, w.Contacts(), "expect contacts of removed body to go with it")
+	assert.Equal(t, []*Body{ground}, w.Bodies())
+	assert.ErrorIs(t, w.RemoveBody(ball), ErrBodyNotInWorld)
+}
+
+// TestWorldStep - test that time is simulated in fixed steps however it is divided between calls
+func TestWorldStep(t *testing.T) {
+	w := NewWorld(Vec2{X: 0, Y: -10})
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0, 1)), 1)
+	assert.Nil(t, w.AddBody(ball))
+	assert.Equal(t, 0, w.Step(w.TimeStep/2))
+	assert.Equal(t, Vec2{}, ball.Velocity)
+	assert.Equal(t, 1, w.Step(w.TimeStep/2))
+	assert.InDelta(t, -10*w.TimeStep, ball.Velocity.Y, 1e-12)
+	assert.Equal(t, 3, w.Step(3*w.TimeStep+w.TimeStep/4))
+
+	// a long pause is cut short rather than simulated in full
+	assert.Equal(t, DefaultMaxSteps, w.Step(1))
+	assert.Equal(t, 0, w.Step(0))
+}
+
+// TestWorldStepSettings - test that negative elapsed time is ignored, and that a time step or step limit that is
+// not positive is taken as its default rather than stepping forever or by zero
+func TestWorldStepSettings(t *testing.T) {
+	w := NewWorld(Vec2{X: 0, Y: -10})
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0, 1)), 1)
+	assert.Nil(t, w.AddBody(ball))
+	assert.Equal(t, 0, w.Step(-1))
+	assert.Equal(t, 0, w.Step(math.NaN()))
+	assert.Equal(t, 1, w.Step(DefaultTimeStep), "expect negative and NaN time not to be owed")
+
+	for _, timeStep := range []float64{0, -DefaultTimeStep, math.NaN()} {
+		w.TimeStep = timeStep
+		assert.Equal(t, 2, w.Step(2*DefaultTimeStep))
+		assert.False(t, math.IsNaN(ball.Position.Y))
+		assert.False(t, math.IsNaN(ball.Velocity.Y))
+	}
+	assert.InDelta(t, -10*7*DefaultTimeStep, ball.Velocity.Y, 1e-9)
+
+	w.TimeStep = DefaultTimeStep
+	for _, maxSteps := range []int{0, -1} {
+		w.MaxSteps = maxSteps
+		assert.Equal(t, DefaultMaxSteps, w.Step(1e9))
+	}
+}
+
+// TestBallRestsOnGround - test that a falling ball comes to rest on the ground without sinking into it
+func TestBallRestsOnGround(t *testing.T) {
+	w, _ := newTestWorld(t)
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 3, 0.5)), 1)
+	assert.Nil(t, w.AddBody(ball))
+	run(w, 180)
+	assert.InDelta(t, 0.5, ball.Position.Y, 2*linearSlop)
+	assert.InDelta(t, 0, ball.Velocity.Length(), 1e-3)
+
+	// the impulse carried between steps holds the ball up against one step of gravity
+	contacts := w.Contacts()
+	assert.Len(t, contacts, 1)
+	assert.Equal(t, ball, contacts[0].BodyB)
+	assert.InDelta(t, ball.Mass()*10*w.TimeStep, contacts[0].Points[0].NormalImpulse, 1e-3)
+}
+
+// TestRestitution - test that a bouncy ball rebounds and a dead ball does not
+func TestRestitution(t *testing.T) {
+	rebound := func(restitution float64) float64 {
+		w, _ := newTestWorld(t)
+		// just touching the ground as it arrives
+		ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0.5, 0.5)), 1)
+		ball.Restitution = restitution
+		ball.Velocity = Vec2{X: 0, Y: -5}
+		assert.Nil(t, w.AddBody(ball))
+		run(w, 1)
+		return ball.Velocity.Y
+	}
+	// gravity over the step adds to the speed of approach
+	approach := 5 + 10*DefaultTimeStep
+	assert.InDelta(t, approach, rebound(1), 1e-9)
+	assert.InDelta(t, approach/2, rebound(0.5), 1e-9)
+	assert.InDelta(t, 0, rebound(0), 1e-9)
+}
+
+// TestElasticCollision - test that equal disks exchange velocities in a head on elastic collision
+func TestElasticCollision(t *testing.T) {
+	w := NewWorld(Vec2{})
+	left := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(-1.05, 0, 1)), 1)
+	right := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(1.05, 0, 1)), 1)
+	for _, b := range []*Body{left, right} {
+		b.Restitution = 1
+		assert.Nil(t, w.AddBody(b))
+	}
+	left.Velocity = Vec2{X: 6, Y: 0}
+	run(w, 10)
+	assert.InDelta(t, 0, left.Velocity.X, 1e-6)
+	assert.InDelta(t, 6, right.Velocity.X, 1e-6)
+	assert.InDelta(t, 0, left.AngularVelocity, 1e-9)
+}
+
+// TestBoxStack - test that a stack of boxes settles upright without drifting or sinking
+func TestBoxStack(t *testing.T) {
+	w, _ := newTestWorld(t)
+	var boxes []*Body
+	for i := 0; i < 5; i++ {
+		y := float32(i) * 1.01
+		b := NewBody(DynamicBody, box(t, -0.5, y, 0.5, y+1), 1)
+		assert.Nil(t, w.AddBody(b))
+		boxes = append(boxes, b)
+	}
+	run(w, 300)
+	for i, b := range boxes {
+		assert.InDelta(t, 0, b.Position.X, 0.01, "box %d drifted", i)
+		assert.InDelta(t, 0.5+float64(i), b.Position.Y, 0.05, "box %d out of place", i)
+		assert.InDelta(t, 0, b.Angle, 0.01, "box %d tipped", i)
+		assert.InDelta(t, 0, b.Velocity.Length(), 0.01, "box %d still moving", i)
+	}
+	assert.Len(t, w.Contacts(), 5)
+	for _, c := range w.Contacts() {
+		assert.Len(t, c.Points, 2)
+	}
+}
+
+// TestFriction - test that a box sliding along the ground is stopped by friction, and a frictionless one is not
+func TestFriction(t *testing.T) {
+	slide := func(friction float64) *Body {
+		w, ground := newTestWorld(t)
+		ground.Friction = friction
+		b := NewBody(DynamicBody, box(t, -0.5, 0, 0.5, 1), 1)
+		b.Friction = friction
+		b.Velocity = Vec2{X: 4, Y: 0}
+		assert.Nil(t, w.AddBody(b))
+		run(w, 120)
+		return b
+	}
+	// with friction 0.5 the box decelerates at 5 and stops after 1.6 units
+	stopped := slide(0.5)
+	assert.InDelta(t, 0, stopped.Velocity.X, 1e-6)
+	assert.InDelta(t, 1.6, stopped.Position.X, 0.1)
+	assert.InDelta(t, 0, stopped.Angle, 0.01)
+
+	frictionless := slide(0)
+	assert.InDelta(t, 4, frictionless.Velocity.X, 1e-6)
+}
+
+// TestWarmStarting - test that warm starting holds up a tall stack that topples when the solver starts cold
+func TestWarmStarting(t *testing.T) {
+	settle := func(warm bool) *Body {
+		w, _ := newTestWorld(t)
+		w.WarmStarting = warm
+		var top *Body
+		for i := 0; i < 10; i++ {
+			y := float32(i)
+			top = NewBody(DynamicBody, box(t, -0.5, y, 0.5, y+1), 1)
+			assert.Nil(t, w.AddBody(top))
+		}
+		run(w, 600)
+		return top
+	}
+	top := settle(true)
+	assert.InDelta(t, 9.5, top.Position.Y, 0.05)
+	assert.InDelta(t, 0, top.Position.X, 0.05)
+	assert.Less(t, settle(false).Position.Y, 1.0, "expect cold stack to have fallen over")
+}
+
+// TestKinematicBody - test that a kinematic body moves at its own velocity and pushes dynamic bodies aside
+func TestKinematicBody(t *testing.T) {
+	w := NewWorld(Vec2{})
+	pusher := NewBody(KinematicBody, box(t, -2, -1, 0, 1), 1)
+	pusher.Velocity = Vec2{X: 1, Y: 0}
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(1, 0, 0.5)), 1)
+	assert.Nil(t, w.AddBody(pusher))
+	assert.Nil(t, w.AddBody(ball))
+	assert.Equal(t, 0.0, pusher.Mass())
+	run(w, 60)
+	assert.InDelta(t, 0, pusher.Position.X, 1e-9)
+	assert.InDelta(t, 1, pusher.Velocity.X, 1e-12)
+	assert.GreaterOrEqual(t, ball.Position.X, pusher.Position.X+1.5-2*linearSlop)
+}
+
+// TestSpinningBallOnGround - test that friction turns spin into rolling
+func TestSpinningBallOnGround(t *testing.T) {
+	w, _ := newTestWorld(t)
+	ball := NewBody(DynamicBody, NewCircleShape(circle.NewCircle(0, 0.5, 0.5)), 1)
+	ball.Friction = 1
+	ball.AngularVelocity = -10
+	assert.Nil(t, w.AddBody(ball))
+	run(w, 120)
+	assert.Greater(t, ball.Velocity.X, 0.0, "expect clockwise spin to roll the ball to the right")
+	assert.InDelta(t, ball.Velocity.X, -ball.AngularVelocity*0.5, 0.05, "expect rolling without slipping")
+	assert.False(t, math.IsNaN(ball.Position.X))
+}
@@ -0,0 +1,36 @@
+package point
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// ErrBinaryLength - returned when binary data is the wrong length for the value being decoded
+var ErrBinaryLength = errors.New("binary data has wrong length")
+
+// BinarySize - number of bytes in the binary encoding of a point
+const BinarySize = 8
+
+// MarshalBinary - encode point as the IEEE 754 bits of X then Y, little endian. The encoding is exact, so a
+// decoded point is bit for bit the point encoded.
+func (A Point) MarshalBinary() ([]byte, error) {
+	return A.AppendBinary(make([]byte, 0, BinarySize)), nil
+}
+
+// AppendBinary - append the binary encoding of point to b and return the extended slice
+func (A Point) AppendBinary(b []byte) []byte {
+	b = binary.LittleEndian.AppendUint32(b, math.Float32bits(A.X))
+	return binary.LittleEndian.AppendUint32(b, math.Float32bits(A.Y))
+}
+
+// UnmarshalBinary - decode point from data made by MarshalBinary. Returns ErrBinaryLength if data is not
+// BinarySize bytes long.
+func (A *Point) UnmarshalBinary(data []byte) error {
+	if len(data) != BinarySize {
+		return ErrBinaryLength
+	}
+	A.X = math.Float32frombits(binary.LittleEndian.Uint32(data))
+	A.Y = math.Float32frombits(binary.LittleEndian.Uint32(data[4:]))
+	return nil
+}
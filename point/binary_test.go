@@ -0,0 +1,29 @@
+package point
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPointBinary - test that points survive a round trip through their binary encoding bit for bit
+func TestPointBinary(t *testing.T) {
+	for _, p := range []Point{{}, {X: 1.5, Y: -2.25}, {X: math.SmallestNonzeroFloat32, Y: float32(math.Copysign(0, -1))}} {
+		data, err := p.MarshalBinary()
+		assert.Nil(t, err)
+		assert.Len(t, data, BinarySize)
+		var decoded Point
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, math.Float32bits(p.X), math.Float32bits(decoded.X))
+		assert.Equal(t, math.Float32bits(p.Y), math.Float32bits(decoded.Y))
+	}
+
+	data := Point{X: 1, Y: 2}.AppendBinary([]byte{9})
+	assert.Equal(t, byte(9), data[0], "expect encoding appended after existing bytes")
+	var decoded Point
+	assert.Nil(t, decoded.UnmarshalBinary(data[1:]))
+	assert.Equal(t, Point{X: 1, Y: 2}, decoded)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data), ErrBinaryLength)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(nil), ErrBinaryLength)
+}
@@ -5,6 +5,9 @@ import (
 	"math"
 )
 
+// ErrNoPoints - returned when an operation requires at least one point
+var ErrNoPoints = errors.New("empty array of points cannot result in minimum and maximum values")
+
 // Point - represents a point in 2D space
 type Point struct {
 	X float32 // position in x dimension
@@ -13,12 +16,12 @@ type Point struct {
 
 // SameX - determine if two points have same x value to within value of Delta
 func (A Point) SameX(B Point) bool {
-	return AreWithinGlobalDelta(A.X, B.X)
+	return A.SameXWithTolerance(B, DefaultTolerance)
 }
 
 // SameY - determine if two points have same y value to within value of Delta
 func (A Point) SameY(B Point) bool {
-	return AreWithinGlobalDelta(A.Y, B.Y)
+	return A.SameYWithTolerance(B, DefaultTolerance)
 }
 
 // AreTouching - determine if two points are touching to within value of Delta
@@ -46,7 +49,7 @@ func (A Point) Distance(B Point) float32 {
 // GetMinMax - return maximum and minimum x and y values from an array of points
 func GetMinMax(points []Point) (minX, maxX, minY, maxY float32, err error) {
 	if len(points) == 0 {
-		err = errors.New("empty array of points cannot result in minimum and maximum values")
+		err = ErrNoPoints
 		return
 	}
 	// instantiate min and max values from first entry in array
@@ -229,3 +229,43 @@ func TestDistance(t *testing.T) {
 	dist = c.Distance(a)
 	assert.Equal(t, float32(13), dist)
 }
+
+// TestToleranceAreWithin - check absolute and relative epsilons of Tolerance behave as expected
+func TestToleranceAreWithin(t *testing.T) {
+	tol := NewTolerance(-0.01, 0)
+	assert.Equal(t, float32(0.01), tol.Absolute, "negative absolute epsilon should be made positive")
+
+	ok := tol.AreWithin(1, 1.005)
+	assert.True(t, ok, "values should be within absolute epsilon")
+
+	ok = tol.AreWithin(1000, 1000.5)
+	assert.False(t, ok, "values should not be within absolute epsilon")
+
+	tol = NewTolerance(0.01, 0.001)
+	ok = tol.AreWithin(1000, 1000.5)
+	assert.True(t, ok, "values should be within relative epsilon")
+
+	ok = tol.AreWithin(1000, 1002)
+	assert.False(t, ok, "values should not be within relative epsilon")
+
+	ok = DefaultTolerance.AreWithin(3.56, 3.560001)
+	assert.Equal(t, AreWithinGlobalDelta(3.56, 3.560001), ok, "default tolerance should match global delta")
+
+	ok = EasyTolerance.AreWithin(3.56, 3.560009)
+	assert.Equal(t, AreWithinEasyDelta(3.56, 3.560009), ok, "easy tolerance should match easy delta")
+}
+
+// TestAreTouchingWithTolerance - test that AreTouchingWithTolerance behaves as expected
+func TestAreTouchingWithTolerance(t *testing.T) {
+	a := Point{X: 1000000, Y: 1000000}
+	b := Point{X: 1000000.06, Y: 1000000}
+
+	ok := a.AreTouchingWithTolerance(b, DefaultTolerance)
+	assert.False(t, ok, "a and b should not be touching under default tolerance")
+
+	ok = a.AreTouchingWithTolerance(b, NewTolerance(0, 0.000001))
+	assert.True(t, ok, "a and b should be touching under relative tolerance")
+
+	ok = a.SameYWithTolerance(b, DefaultTolerance)
+	assert.True(t, ok, "a and b should share same y value")
+}
@@ -0,0 +1,48 @@
+package point
+
+// Tolerance - carries the absolute and relative epsilons used when deciding near equality of two floats.
+// Two floats a and b are near equal if | a - b | < Absolute or | a - b | < Relative * max(|a|, |b|).
+// The relative epsilon allows the same predicate to be used on data of very different scales.
+type Tolerance struct {
+	Absolute float32 // absolute epsilon
+	Relative float32 // relative epsilon, scaled by the larger magnitude of the values compared
+}
+
+// DefaultTolerance - tolerance equivalent to the global Delta
+var DefaultTolerance = Tolerance{Absolute: Delta}
+
+// EasyTolerance - tolerance equivalent to the global EasyDelta
+var EasyTolerance = Tolerance{Absolute: EasyDelta}
+
+// NewTolerance - returns a new tolerance. If either epsilon is provided as a negative, then abs value is assigned
+func NewTolerance(absolute, relative float32) Tolerance {
+	return Tolerance{Absolute: Abs(absolute), Relative: Abs(relative)}
+}
+
+// AreWithin - determine whether two float values a and b are near equal under the tolerance
+func (t Tolerance) AreWithin(a, b float32) bool {
+	difference := Abs(a - b)
+	if difference < t.Absolute {
+		return true
+	}
+	magnitude := Abs(a)
+	if Abs(b) > magnitude {
+		magnitude = Abs(b)
+	}
+	return difference < t.Relative*magnitude
+}
+
+// SameXWithTolerance - determine if two points have same x value under the tolerance
+func (A Point) SameXWithTolerance(B Point, tol Tolerance) bool {
+	return tol.AreWithin(A.X, B.X)
+}
+
+// SameYWithTolerance - determine if two points have same y value under the tolerance
+func (A Point) SameYWithTolerance(B Point, tol Tolerance) bool {
+	return tol.AreWithin(A.Y, B.Y)
+}
+
+// AreTouchingWithTolerance - determine if two points are touching under the tolerance
+func (A Point) AreTouchingWithTolerance(B Point, tol Tolerance) bool {
+	return A.SameXWithTolerance(B, tol) && A.SameYWithTolerance(B, tol)
+}
@@ -0,0 +1,183 @@
+package polygon
+
+import (
+	"collision/circle"
+	"collision/point"
+	"collision/robust"
+)
+
+// RoundedXYPolygon - convex polygon with rounded corners: every point within Radius of the convex Core,
+// as produced by the Minkowski sum of a polygon and a circle
+type RoundedXYPolygon struct {
+	Core   *XYPolygon // convex polygon swept by the circle
+	Radius float32    // radius of circle swept around core
+}
+
+// IsConvex - boolean indicating whether polygon is valid and convex. Collinear vertices are allowed.
+func (p *XYPolygon) IsConvex() bool {
+	_, err := convexRing(p)
+	return err == nil
+}
+
+// MinkowskiSum - returns the convex polygon formed by adding every point of a to every point of b. Both
+// polygons must be valid and convex. The sum is clockwise, as XYRectangle, with collinear vertices removed.
+func MinkowskiSum(a, b *XYPolygon) (*XYPolygon, error) {
+	ringA, err := convexRing(a)
+	if err != nil {
+		return &XYPolygon{}, err
+	}
+	ringB, err := convexRing(b)
+	if err != nil {
+		return &XYPolygon{}, err
+	}
+	return minkowskiSum(ringA, ringB)
+}
+
+// MinkowskiDifference - returns the convex polygon formed by subtracting every point of b from every point
+// of a. The polygons intersect if and only if the difference contains the origin, and the distance between
+// them is the distance from the origin to the difference. Both polygons must be valid and convex.
+func MinkowskiDifference(a, b *XYPolygon) (*XYPolygon, error) {
+	ringA, err := convexRing(a)
+	if err != nil {
+		return &XYPolygon{}, err
+	}
+	ringB, err := convexRing(b)
+	if err != nil {
+		return &XYPolygon{}, err
+	}
+	// reflecting b through the origin keeps it counterclockwise
+	for i, v := range ringB {
+		ringB[i] = point.Point{X: -v.X, Y: -v.Y}
+	}
+	return minkowskiSum(ringA, lowestFirst(ringB))
+}
+
+// MinkowskiSumCircle - returns the polygon swept by a circle whose centre moves over the convex polygon p:
+// the polygon grown by the radius of the circle, with rounded corners, and moved by the circle centre
+func MinkowskiSumCircle(p *XYPolygon, c circle.Circle) (*RoundedXYPolygon, error) {
+	ring, err := convexRing(p)
+	if err != nil {
+		return &RoundedXYPolygon{}, err
+	}
+	centre, radius := c.GetCentreAndRadius()
+	for i, v := range ring {
+		ring[i] = point.Point{X: v.X + centre.X, Y: v.Y + centre.Y}
+	}
+	reverseRing(ring)
+	core := &XYPolygon{Vertices: lowestFirst(ring)}
+	core.PopulateEdges()
+	return &RoundedXYPolygon{Core: core, Radius: radius}, nil
+}
+
+// ContainsPoint - boolean indicating whether a point lies inside or on the boundary of the rounded polygon
+func (r *RoundedXYPolygon) ContainsPoint(p point.Point) bool {
+	return r.containsPoint(p, func(distance float64) bool {
+		return distance <= float64(r.Radius)
+	})
+}
+
+// ContainsPointWithTolerance - boolean indicating whether a point lies inside the rounded polygon, or on its
+// boundary under the tolerance
+func (r *RoundedXYPolygon) ContainsPointWithTolerance(p point.Point, tol point.Tolerance) bool {
+	return r.containsPoint(p, func(distance float64) bool {
+		return distance <= float64(r.Radius) || tol.AreWithin(float32(distance), r.Radius)
+	})
+}
+
+// containsPoint - boolean indicating whether a point lies in the core, or at a distance from it accepted by
+// the predicate provided
+func (r *RoundedXYPolygon) containsPoint(p point.Point, withinRadius func(distance float64) bool) bool {
+	if r.Core.ContainsPoint(p) {
+		return true
+	}
+	if len(r.Core.Edges) != len(r.Core.Vertices) {
+		r.Core.PopulateEdges()
+	}
+	for _, edge := range r.Core.Edges {
+		if withinRadius(distanceToSegment(p, edge)) {
+			return true
+		}
+	}
+	return false
+}
+
+// XYPolygon - returns the rounded polygon with each corner approximated by chords of its arc, deviating from
+// the arc by no more than ArcTolerance times the radius. The approximation lies within the rounded polygon.
+func (r *RoundedXYPolygon) XYPolygon() (*XYPolygon, error) {
+	if r.Radius == 0 {
+		return NewValidatedXYPolygon(append([]point.Point{}, r.Core.Vertices...))
+	}
+	regions, err := r.Core.Offset(r.Radius, JoinRound)
+	if err != nil {
+		return &XYPolygon{}, err
+	}
+	// offsetting a convex polygon outward always gives a single region without holes
+	return regions[0].Outer, nil
+}
+
+// convexRing - vertices of a valid convex polygon with collinear vertices removed, counterclockwise and
+// starting from the lowest vertex, or an error if the polygon is invalid or not convex
+func convexRing(p *XYPolygon) ([]point.Point, error) {
+	if _, _, _, err := p.ValidateXYPolygon(); err != nil {
+		return nil, err
+	}
+	// a simple polygon turning the same way at every vertex is convex
+	order := len(p.Vertices)
+	turning := robust.Collinear
+	for i := 0; i < order; i++ {
+		prev, vertex, next := p.Vertices[(i+order-1)%order], p.Vertices[i], p.Vertices[(i+1)%order]
+		turn := robust.Orient(prev, vertex, next)
+		if turn == robust.Collinear {
+			continue
+		}
+		if turning == robust.Collinear {
+			turning = turn
+		} else if turn != turning {
+			return nil, &NotConvexError{Index: i, Vertex: vertex}
+		}
+	}
+	ring := removeDegenerateVertices(p.Vertices)
+	if len(ring) < 3 {
+		return nil, &DimensionError{Got: len(ring)}
+	}
+	if signedArea(ring) < 0 {
+		reverseRing(ring)
+	}
+	return lowestFirst(ring), nil
+}
+
+// lowestFirst - copy of ring rotated to start at the vertex with least y, then least x
+func lowestFirst(ring []point.Point) []point.Point {
+	lowest := 0
+	for i, v := range ring {
+		if v.Y < ring[lowest].Y || (v.Y == ring[lowest].Y && v.X < ring[lowest].X) {
+			lowest = i
+		}
+	}
+	return append(ring[lowest:], ring[:lowest]...)
+}
+
+// minkowskiSum - sum of two convex counterclockwise rings starting at their lowest vertices, found by merging
+// their edges in order of angle
+func minkowskiSum(a, b []point.Point) (*XYPolygon, error) {
+	n, m := len(a), len(b)
+	sum := make([]point.Point, 0, n+m)
+	for i, j := 0, 0; i < n || j < m; {
+		sum = append(sum, point.Point{X: a[i%n].X + b[j%m].X, Y: a[i%n].Y + b[j%m].Y})
+		edgeA := toVec(a[(i+1)%n]).sub(toVec(a[i%n]))
+		edgeB := toVec(b[(j+1)%m]).sub(toVec(b[j%m]))
+		cross := edgeA.cross(edgeB)
+		// take whichever edge turns least, or both together if they are parallel
+		advanceA := i < n && (j == m || cross >= 0)
+		advanceB := j < m && (i == n || cross <= 0)
+		if advanceA {
+			i++
+		}
+		if advanceB {
+			j++
+		}
+	}
+	ring := removeDegenerateVertices(sum)
+	reverseRing(ring)
+	return NewValidatedXYPolygon(lowestFirst(ring))
+}
@@ -0,0 +1,99 @@
+package polygon_test
+
+import (
+	"collision/circle"
+	"collision/point"
+	"collision/polygon"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMinkowskiSum - test sum of convex polygons
+func TestMinkowskiSum(t *testing.T) {
+	square, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 0}})
+	assert.Nil(t, err)
+	// counterclockwise triangle is accepted as well
+	triangle, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}})
+	assert.Nil(t, err)
+
+	sum, err := polygon.MinkowskiSum(square, triangle)
+	assert.Nil(t, err, "no error expected")
+	expected := []point.Point{{X: 0, Y: 0}, {X: 0, Y: 3}, {X: 2, Y: 3}, {X: 3, Y: 2}, {X: 3, Y: 0}}
+	assert.Equal(t, expected, sum.Vertices, "expect square with one corner cut")
+	assert.True(t, sum.IsClockwise(), "expect clockwise sum")
+	assert.InDelta(t, -(4 + 2 + 2 + 0.5), sum.SignedArea(), 1e-6)
+
+	// parallel edges are merged rather than leaving collinear vertices
+	sum, err = polygon.MinkowskiSum(square, square)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, []point.Point{{X: 0, Y: 0}, {X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 0}}, sum.Vertices)
+
+	// concave polygon is rejected
+	arrow, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 0, Y: 0}, {X: 2, Y: 4}, {X: 4, Y: 0}, {X: 2, Y: 1}})
+	assert.Nil(t, err)
+	assert.False(t, arrow.IsConvex(), "expect arrow head to be concave")
+	_, err = polygon.MinkowskiSum(square, arrow)
+	var notConvex *polygon.NotConvexError
+	assert.True(t, errors.As(err, &notConvex), "expect not convex error")
+	assert.Equal(t, point.Point{X: 2, Y: 1}, notConvex.Vertex, "expect reflex vertex")
+	assert.True(t, errors.Is(err, polygon.ErrNotConvex))
+	assert.False(t, errors.Is(err, polygon.ErrInvalidPolygon), "expect concave polygon to be valid")
+}
+
+// TestMinkowskiDifference - test that difference contains origin exactly when polygons intersect
+func TestMinkowskiDifference(t *testing.T) {
+	square, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 0}})
+	assert.Nil(t, err)
+	origin := point.Point{X: 0, Y: 0}
+
+	tests := []struct {
+		name       string
+		other      []point.Point
+		intersects bool
+	}{
+		{"overlapping", []point.Point{{X: 1, Y: 1}, {X: 1, Y: 3}, {X: 3, Y: 1}}, true},
+		{"touching", []point.Point{{X: 2, Y: 0}, {X: 3, Y: 2}, {X: 4, Y: 0}}, true},
+		{"separate", []point.Point{{X: 3, Y: 0}, {X: 4, Y: 2}, {X: 5, Y: 0}}, false},
+		{"inside", []point.Point{{X: 0.5, Y: 0.5}, {X: 1, Y: 1.5}, {X: 1.5, Y: 0.5}}, true},
+	}
+	for _, test := range tests {
+		other, err := polygon.NewValidatedXYPolygon(test.other)
+		assert.Nil(t, err, test.name)
+		difference, err := polygon.MinkowskiDifference(square, other)
+		assert.Nil(t, err, test.name)
+		assert.True(t, difference.IsClockwise(), test.name)
+		assert.Equal(t, test.intersects, difference.ContainsPoint(origin), test.name)
+	}
+
+	// difference of a polygon with itself is centred on the origin
+	difference, err := polygon.MinkowskiDifference(square, square)
+	assert.Nil(t, err)
+	assert.Equal(t, []point.Point{{X: -2, Y: -2}, {X: -2, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: -2}}, difference.Vertices)
+}
+
+// TestMinkowskiSumCircle - test rounded polygon from sum of polygon and circle
+func TestMinkowskiSumCircle(t *testing.T) {
+	square, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 0}})
+	assert.Nil(t, err)
+
+	rounded, err := polygon.MinkowskiSumCircle(square, circle.NewCircle(10, 0, 1))
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, float32(1), rounded.Radius)
+	assert.Equal(t, []point.Point{{X: 10, Y: 0}, {X: 10, Y: 2}, {X: 12, Y: 2}, {X: 12, Y: 0}}, rounded.Core.Vertices, "expect core moved by circle centre")
+
+	assert.True(t, rounded.ContainsPoint(point.Point{X: 11, Y: 1}), "expect point in core")
+	assert.True(t, rounded.ContainsPoint(point.Point{X: 13, Y: 1}), "expect point on flat side")
+	assert.True(t, rounded.ContainsPoint(point.Point{X: 12.7, Y: 2.7}), "expect point within rounded corner")
+	assert.False(t, rounded.ContainsPoint(point.Point{X: 12.8, Y: 2.8}), "expect point outside rounded corner")
+	assert.False(t, rounded.ContainsPoint(point.Point{X: 5, Y: 1}), "expect point outside")
+
+	approximation, err := rounded.XYPolygon()
+	assert.Nil(t, err, "no error expected")
+	assert.True(t, approximation.IsClockwise(), "expect clockwise approximation")
+	assert.True(t, approximation.IsConvex(), "expect convex approximation")
+	for _, v := range approximation.Vertices {
+		assert.True(t, rounded.ContainsPointWithTolerance(v, point.EasyTolerance), "expect approximation within rounded polygon")
+	}
+}
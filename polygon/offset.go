@@ -0,0 +1,279 @@
+package polygon
+
+import (
+	"collision/point"
+	"math"
+)
+
+// JoinType - how offset edges are joined where they separate at a vertex
+type JoinType int
+
+const (
+	JoinMiter  JoinType = iota // extend edges until they meet, falling back to square beyond MiterLimit
+	JoinRound                  // join with a circular arc centred on the vertex
+	JoinSquare                 // cut the corner square, at the offset distance from the vertex
+)
+
+// MiterLimit - maximum distance of a miter join from its vertex, as a multiple of the offset distance
+const MiterLimit = 2
+
+// ArcTolerance - maximum deviation of round joins from a true arc, as a fraction of the offset distance
+const ArcTolerance = 0.0025
+
+// Offset - returns the region within distance of the polygon (inflate) for positive distance, or the polygon
+// shrunk by distance (deflate) for negative distance. Inflating a concave polygon may enclose holes, and
+// deflating may split the polygon into several pieces or make it vanish, in which case no regions are
+// returned. The polygon must be valid. Where the offset outline crosses itself in complicated ways, for
+// example when several separate parts collapse into each other at once, the regions returned may overlap.
+func (p *XYPolygon) Offset(distance float32, join JoinType) ([]*XYPolygonWithHoles, error) {
+	if _, _, _, err := p.ValidateXYPolygon(); err != nil {
+		return nil, err
+	}
+	ring := removeDegenerateVertices(p.Vertices)
+	if len(ring) < 3 {
+		return nil, &DimensionError{Got: len(ring)}
+	}
+	if distance == 0 {
+		outer := &XYPolygon{Vertices: ring}
+		if !outer.IsClockwise() {
+			reverseRing(outer.Vertices)
+		}
+		outer.PopulateEdges()
+		return []*XYPolygonWithHoles{{Outer: outer}}, nil
+	}
+	// offsetting is done on a counterclockwise ring, so the outward normal of each edge is on its right
+	if signedArea(ring) < 0 {
+		reverseRing(ring)
+	}
+	raw := rawOffset(ring, float64(distance), join)
+	return regionsFromRawOffset(raw), nil
+}
+
+// Offset - returns the region within distance of the rectangle, or the rectangle shrunk by distance if
+// distance is negative. See XYPolygon.Offset.
+func (r *XYRectangle) Offset(distance float32, join JoinType) ([]*XYPolygonWithHoles, error) {
+	p := &XYPolygon{Vertices: append([]point.Point{}, r.Vertices[:]...)}
+	return p.Offset(distance, join)
+}
+
+// vec - double precision vector used while offsetting
+type vec struct {
+	x, y float64
+}
+
+// add - vector sum
+func (a vec) add(b vec) vec {
+	return vec{a.x + b.x, a.y + b.y}
+}
+
+// sub - vector difference
+func (a vec) sub(b vec) vec {
+	return vec{a.x - b.x, a.y - b.y}
+}
+
+// scale - vector multiplied by scalar
+func (a vec) scale(s float64) vec {
+	return vec{a.x * s, a.y * s}
+}
+
+// dot - dot product
+func (a vec) dot(b vec) float64 {
+	return a.x*b.x + a.y*b.y
+}
+
+// cross - z component of cross product, positive if b is counterclockwise of a
+func (a vec) cross(b vec) float64 {
+	return a.x*b.y - a.y*b.x
+}
+
+// length - magnitude of vector
+func (a vec) length() float64 {
+	return math.Hypot(a.x, a.y)
+}
+
+// unit - vector of unit length in same direction
+func (a vec) unit() vec {
+	return a.scale(1 / a.length())
+}
+
+// point - vector as a point
+func (a vec) point() point.Point {
+	return point.Point{X: float32(a.x), Y: float32(a.y)}
+}
+
+// isFinite - boolean indicating neither component is NaN or infinite
+func (a vec) isFinite() bool {
+	return !math.IsNaN(a.x) && !math.IsNaN(a.y) && !math.IsInf(a.x, 0) && !math.IsInf(a.y, 0)
+}
+
+// toVec - point as a vector
+func toVec(p point.Point) vec {
+	return vec{float64(p.X), float64(p.Y)}
+}
+
+// rightNormal - direction rotated clockwise by a right angle
+func rightNormal(direction vec) vec {
+	return vec{direction.y, -direction.x}
+}
+
+// leftNormal - direction rotated counterclockwise by a right angle
+func leftNormal(direction vec) vec {
+	return vec{-direction.y, direction.x}
+}
+
+// rawOffset - offset every edge of a counterclockwise ring by distance and join consecutive offset edges.
+// Where offset edges overlap, the vertex itself is inserted between them so that the raw outline winds
+// around the overlap, which is then removed by regionsFromRawOffset.
+func rawOffset(ring []point.Point, distance float64, join JoinType) []point.Point {
+	order := len(ring)
+	raw := make([]point.Point, 0, 3*order)
+	for i := 0; i < order; i++ {
+		prev, v, next := toVec(ring[(i+order-1)%order]), toVec(ring[i]), toVec(ring[(i+1)%order])
+		d0, d1 := v.sub(prev).unit(), next.sub(v).unit()
+		n0, n1 := rightNormal(d0), rightNormal(d1)
+		a, b := v.add(n0.scale(distance)), v.add(n1.scale(distance))
+		turn := d0.cross(d1)
+
+		// collinear edges need only one point
+		if math.Abs(turn) < 1e-12 && d0.dot(d1) > 0 {
+			raw = append(raw, a.point())
+			continue
+		}
+		// offset edges overlap at right turns when inflating and at left turns when deflating
+		if turn*distance < 0 {
+			raw = append(raw, a.point(), v.point(), b.point())
+			continue
+		}
+		raw = append(raw, a.point())
+		switch join {
+		case JoinMiter:
+			cosine := n0.dot(n1)
+			if ratio := math.Sqrt(2 / (1 + cosine)); 1+cosine > 1e-12 && ratio <= MiterLimit {
+				raw = append(raw, v.add(n0.add(n1).scale(distance/(1+cosine))).point())
+			} else {
+				raw = append(raw, squareJoin(v, d0, d1, n0, n1, distance)...)
+			}
+		case JoinSquare:
+			raw = append(raw, squareJoin(v, d0, d1, n0, n1, distance)...)
+		case JoinRound:
+			raw = append(raw, roundJoin(v, n0, n1, distance)...)
+		}
+		raw = append(raw, b.point())
+	}
+	return raw
+}
+
+// squareJoin - corner points cutting a join square, perpendicular to the bisector at distance from vertex
+func squareJoin(v, d0, d1, n0, n1 vec, distance float64) []point.Point {
+	bisector := n0.add(n1)
+	if bisector.length() < 1e-12 {
+		// edges double back on themselves, so cap with the square end of the first edge
+		return []point.Point{v.add(n0.scale(distance)).add(d0.scale(math.Abs(distance))).point(), v.add(n1.scale(distance)).add(d0.scale(math.Abs(distance))).point()}
+	}
+	m := bisector.unit()
+	s0 := distance * (1 - n0.dot(m)) / d0.dot(m)
+	s1 := distance * (1 - n1.dot(m)) / d1.dot(m)
+	q0 := v.add(n0.scale(distance)).add(d0.scale(s0))
+	q1 := v.add(n1.scale(distance)).add(d1.scale(s1))
+	if !q0.isFinite() || !q1.isFinite() {
+		return nil
+	}
+	return []point.Point{q0.point(), q1.point()}
+}
+
+// roundJoin - points strictly between the ends of a circular arc of radius |distance| centred on v
+func roundJoin(v, n0, n1 vec, distance float64) []point.Point {
+	radius := math.Abs(distance)
+	start := math.Atan2(n0.y*distance, n0.x*distance)
+	sweep := math.Atan2(n1.y*distance, n1.x*distance) - start
+	// arc turns the same way as the outline, which is clockwise when deflating
+	if distance > 0 && sweep < 0 {
+		sweep += 2 * math.Pi
+	} else if distance < 0 && sweep > 0 {
+		sweep -= 2 * math.Pi
+	}
+	step := 2 * math.Acos(1-ArcTolerance)
+	steps := int(math.Ceil(math.Abs(sweep) / step))
+	arc := make([]point.Point, 0, steps)
+	for k := 1; k < steps; k++ {
+		angle := start + sweep*float64(k)/float64(steps)
+		arc = append(arc, v.add(vec{math.Cos(angle), math.Sin(angle)}.scale(radius)).point())
+	}
+	return arc
+}
+
+// regionsFromRawOffset - split a raw offset outline into simple rings and keep those bounding the area it
+// winds around positively. Each kept ring is an outer boundary if the filled area is inside it, or a hole
+// if the filled area is outside it.
+func regionsFromRawOffset(raw []point.Point) []*XYPolygonWithHoles {
+	var outers, holes []*XYPolygon
+	var holeSamples []vec
+	for _, ring := range splitRing(removeDegenerateVertices(raw)) {
+		inside, outside, ok := sampleEitherSide(ring)
+		if !ok {
+			continue
+		}
+		filledInside := windingNumber(raw, inside.x, inside.y) > 0
+		filledOutside := windingNumber(raw, outside.x, outside.y) > 0
+		if filledInside == filledOutside {
+			// ring lies within or outside filled area rather than bounding it
+			continue
+		}
+		clockwise := signedArea(ring) < 0
+		if filledInside != clockwise {
+			// outer boundaries are clockwise, holes counterclockwise
+			reverseRing(ring)
+		}
+		q := &XYPolygon{Vertices: ring}
+		q.PopulateEdges()
+		if filledInside {
+			outers = append(outers, q)
+		} else {
+			holes = append(holes, q)
+			holeSamples = append(holeSamples, inside)
+		}
+	}
+
+	regions := make([]*XYPolygonWithHoles, len(outers))
+	for i, outer := range outers {
+		regions[i] = &XYPolygonWithHoles{Outer: outer}
+	}
+	// assign each hole to the smallest outer boundary containing it
+	for h, hole := range holes {
+		best := -1
+		for i, outer := range outers {
+			if windingNumber(outer.Vertices, holeSamples[h].x, holeSamples[h].y) == 0 {
+				continue
+			}
+			if best < 0 || math.Abs(float64(outer.SignedArea())) < math.Abs(float64(outers[best].SignedArea())) {
+				best = i
+			}
+		}
+		if best >= 0 {
+			regions[best].Holes = append(regions[best].Holes, hole)
+		}
+	}
+	return regions
+}
+
+// sampleEitherSide - points just inside and just outside a simple ring, either side of its longest edge
+func sampleEitherSide(ring []point.Point) (inside, outside vec, ok bool) {
+	order := len(ring)
+	longest, longestLength := 0, 0.0
+	for i := 0; i < order; i++ {
+		if l := toVec(ring[(i+1)%order]).sub(toVec(ring[i])).length(); l > longestLength {
+			longest, longestLength = i, l
+		}
+	}
+	if longestLength == 0 {
+		return vec{}, vec{}, false
+	}
+	a, b := toVec(ring[longest]), toVec(ring[(longest+1)%order])
+	midpoint := a.add(b).scale(0.5)
+	inward := leftNormal(b.sub(a).unit())
+	if signedArea(ring) < 0 {
+		inward = inward.scale(-1)
+	}
+	epsilon := 1e-3 * longestLength
+	return midpoint.add(inward.scale(epsilon)), midpoint.sub(inward.scale(epsilon)), true
+}
@@ -0,0 +1,98 @@
+package polygon_test
+
+import (
+	"collision/point"
+	"collision/polygon"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// regionArea - area of region, outer boundary less holes
+func regionArea(r *polygon.XYPolygonWithHoles) float64 {
+	area := -float64(r.Outer.SignedArea())
+	for _, hole := range r.Holes {
+		area -= float64(hole.SignedArea())
+	}
+	return area
+}
+
+// TestOffsetSquare - test inflating and deflating a square with each join type
+func TestOffsetSquare(t *testing.T) {
+	r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 0, Y: 0}, {X: 10, Y: 10}})
+	assert.Nil(t, err)
+
+	regions, err := r.Offset(1, polygon.JoinMiter)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 1, len(regions), "expect single region")
+	assert.InDelta(t, 144, regionArea(regions[0]), 1e-3, "expect 12 by 12 square")
+	assert.True(t, regions[0].Outer.IsClockwise(), "expect clockwise outer boundary")
+	assert.True(t, regions[0].ContainsPoint(point.Point{X: -0.9, Y: -0.9}), "expect mitred corner to be contained")
+
+	regions, err = r.Offset(1, polygon.JoinRound)
+	assert.Nil(t, err, "no error expected")
+	assert.InDelta(t, 140+math.Pi, regionArea(regions[0]), 0.01, "expect square with rounded corners")
+	assert.False(t, regions[0].ContainsPoint(point.Point{X: -0.9, Y: -0.9}), "expect rounded corner to exclude point")
+	assert.True(t, regions[0].Outer.Validate().Valid(), "expect valid outer boundary")
+
+	regions, err = r.Offset(1, polygon.JoinSquare)
+	assert.Nil(t, err, "no error expected")
+	assert.InDelta(t, 144-4*(2-math.Sqrt2)*(2-math.Sqrt2)/2*1, regionArea(regions[0]), 0.01, "expect square with cut corners")
+
+	regions, err = r.Offset(-2, polygon.JoinRound)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 1, len(regions), "expect single region")
+	assert.InDelta(t, 36, regionArea(regions[0]), 1e-3, "expect 6 by 6 square")
+
+	regions, err = r.Offset(-6, polygon.JoinMiter)
+	assert.Nil(t, err, "no error expected")
+	assert.Empty(t, regions, "expect square to vanish")
+}
+
+// TestOffsetConcave - test that offsetting concave polygons encloses holes and splits pieces
+func TestOffsetConcave(t *testing.T) {
+	// C shape whose gap closes when inflated, enclosing a hole
+	c, err := polygon.NewValidatedXYPolygon([]point.Point{
+		{X: 0, Y: 0}, {X: 0, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 6}, {X: 9, Y: 6}, {X: 9, Y: 9},
+		{X: 1, Y: 9}, {X: 1, Y: 1}, {X: 9, Y: 1}, {X: 9, Y: 4}, {X: 10, Y: 4}, {X: 10, Y: 0},
+	})
+	assert.Nil(t, err)
+	regions, err := c.Offset(1.5, polygon.JoinMiter)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 1, len(regions), "expect single region")
+	assert.Equal(t, 1, len(regions[0].Holes), "expect gap to close around a hole")
+	assert.False(t, regions[0].Holes[0].IsClockwise(), "expect counterclockwise hole")
+	assert.False(t, regions[0].ContainsPoint(point.Point{X: 5, Y: 5}), "expect centre of hole to be excluded")
+	assert.True(t, regions[0].ContainsPoint(point.Point{X: 10.5, Y: 5}), "expect closed gap to be contained")
+	assert.InDelta(t, 13*13-5*5, regionArea(regions[0]), 1e-3, "expect hole of 5 by 5")
+
+	// dumbbell whose neck vanishes when deflated
+	dumbbell, err := polygon.NewValidatedXYPolygon([]point.Point{
+		{X: 0, Y: 0}, {X: 0, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 6}, {X: 20, Y: 6}, {X: 20, Y: 10},
+		{X: 30, Y: 10}, {X: 30, Y: 0}, {X: 20, Y: 0}, {X: 20, Y: 4}, {X: 10, Y: 4}, {X: 10, Y: 0},
+	})
+	assert.Nil(t, err)
+	regions, err = dumbbell.Offset(-2, polygon.JoinMiter)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 2, len(regions), "expect dumbbell to split in two")
+	for _, region := range regions {
+		assert.InDelta(t, 36, regionArea(region), 1e-3, "expect 6 by 6 square")
+		assert.True(t, region.Outer.Validate().Valid(), "expect valid outer boundary")
+	}
+
+	// invalid polygon cannot be offset
+	bowtie := &polygon.XYPolygon{Vertices: []point.Point{{X: 0, Y: 0}, {X: 2, Y: 2}, {X: 2, Y: 0}, {X: 0, Y: 2}}}
+	_, err = bowtie.Offset(1, polygon.JoinMiter)
+	assert.NotNil(t, err, "expect bowtie to be rejected")
+}
+
+// TestXYPolygonContainsPoint - test point containment for XYPolygon
+func TestXYPolygonContainsPoint(t *testing.T) {
+	p, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 0, Y: 0}, {X: 0, Y: 10}, {X: 5, Y: 2}, {X: 10, Y: 10}, {X: 10, Y: 0}})
+	assert.Nil(t, err)
+	assert.True(t, p.ContainsPoint(point.Point{X: 1, Y: 1}), "expect point inside")
+	assert.True(t, p.ContainsPoint(point.Point{X: 0, Y: 5}), "expect point on boundary")
+	assert.False(t, p.ContainsPoint(point.Point{X: 5, Y: 5}), "expect point in notch to be outside")
+	assert.False(t, p.ContainsPoint(point.Point{X: 11, Y: 1}), "expect point outside")
+}
@@ -3,49 +3,263 @@ package polygon
 import (
 	"collision/line"
 	"collision/point"
+	"errors"
 	"fmt"
 )
 
-func DimensionError(dimensions int) error {
-	return fmt.Errorf("require at least 3 points, the array of points provided was of length %d", dimensions)
+// sentinel errors - every validation error matches ErrInvalidPolygon and one of the more specific
+// sentinels below when tested with errors.Is
+var (
+	ErrInvalidPolygon   = errors.New("invalid polygon")
+	ErrDimension        = errors.New("wrong number of vertices")
+	ErrSelfIntersection = errors.New("polygon edges intersect")
+	ErrDuplicateVertex  = errors.New("duplicate vertex")
+	ErrNotXYRectangle   = errors.New("vertices do not form an XYRectangle")
+	ErrZeroLengthEdge   = errors.New("zero length edge")
+	ErrCollinear        = errors.New("collinear vertices")
+	ErrWinding          = errors.New("wrong winding")
+	ErrUnrepaired       = errors.New("polygon could not be fully repaired")
+	ErrNotConvex        = errors.New("polygon is not convex")
+)
+
+// DimensionError - polygon has fewer than three vertices
+type DimensionError struct {
+	Got int // number of vertices provided
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("require at least 3 points, the array of points provided was of length %d", e.Got)
+}
+
+func (e *DimensionError) Is(target error) bool {
+	return target == ErrDimension || target == ErrInvalidPolygon
+}
+
+// SelfIntersectionError - two edges of a polygon intersect away from their shared vertices
+type SelfIntersectionError struct {
+	Seg1  line.LineSegment // first edge
+	Seg2  line.LineSegment // second edge
+	Point point.Point      // point at which the edges intersect
+}
+
+func (e *SelfIntersectionError) Error() string {
+	return fmt.Sprintf("polygon lines intersect: line %#v and line %#v intersect at point %#v", e.Seg1, e.Seg2, e.Point)
+}
+
+func (e *SelfIntersectionError) Is(target error) bool {
+	return target == ErrSelfIntersection || target == ErrInvalidPolygon
+}
+
+// RectangleDimensionError - rectangle was not given exactly four vertices
+type RectangleDimensionError struct {
+	Got int // number of vertices provided
+}
+
+func (e *RectangleDimensionError) Error() string {
+	return fmt.Sprintf("four corners required to instantiate this rectangle, the array of points provided was of length %d", e.Got)
+}
+
+func (e *RectangleDimensionError) Is(target error) bool {
+	return target == ErrDimension || target == ErrInvalidPolygon
+}
+
+// OppositeCornersXYRectangleDimensionError - rectangle from opposite corners was not given exactly two vertices
+type OppositeCornersXYRectangleDimensionError struct {
+	Got int // number of vertices provided
+}
+
+func (e *OppositeCornersXYRectangleDimensionError) Error() string {
+	return fmt.Sprintf("instantiating rectangle from two opposite corners requires exactly two points to be passed, the array of points was of length %d", e.Got)
+}
+
+func (e *OppositeCornersXYRectangleDimensionError) Is(target error) bool {
+	return target == ErrDimension || target == ErrInvalidPolygon
+}
+
+// OppositeCornersXYRectangleSameXError - opposite corners share an x value
+type OppositeCornersXYRectangleSameXError struct {
+	X float32 // shared x value
+}
+
+func (e *OppositeCornersXYRectangleSameXError) Error() string {
+	return fmt.Sprintf("both corners passed shared the same x value: %v", e.X)
+}
+
+func (e *OppositeCornersXYRectangleSameXError) Is(target error) bool {
+	return target == ErrNotXYRectangle || target == ErrInvalidPolygon
+}
+
+// OppositeCornersXYRectangleSameYError - opposite corners share a y value
+type OppositeCornersXYRectangleSameYError struct {
+	Y float32 // shared y value
+}
+
+func (e *OppositeCornersXYRectangleSameYError) Error() string {
+	return fmt.Sprintf("both corners passed shared the same y value: %v", e.Y)
+}
+
+func (e *OppositeCornersXYRectangleSameYError) Is(target error) bool {
+	return target == ErrNotXYRectangle || target == ErrInvalidPolygon
+}
+
+// PointsAreTouchingError - two vertices are the same point
+type PointsAreTouchingError struct {
+	A point.Point // first vertex
+	B point.Point // second vertex
+}
+
+func (e *PointsAreTouchingError) Error() string {
+	return fmt.Sprintf("two of the four points passed are the same point - a: %#v, b: %#v", e.A, e.B)
+}
+
+func (e *PointsAreTouchingError) Is(target error) bool {
+	return target == ErrDuplicateVertex || target == ErrInvalidPolygon
+}
+
+// SharedMinMaxError - minimum and maximum corners of a rectangle share an x or y value
+type SharedMinMaxError struct {
+	Min point.Point // minimum corner
+	Max point.Point // maximum corner
+}
+
+func (e *SharedMinMaxError) Error() string {
+	return fmt.Sprintf("min point %#v and max point %#v share and x or y value", e.Min, e.Max)
+}
+
+func (e *SharedMinMaxError) Is(target error) bool {
+	return target == ErrNotXYRectangle || target == ErrInvalidPolygon
+}
+
+// PointNotOnMaxOrMinXYRectangleError - a vertex does not share its coordinates with the minimum or maximum corner
+type PointNotOnMaxOrMinXYRectangleError struct {
+	Point point.Point // offending vertex
+	Min   point.Point // minimum corner
+	Max   point.Point // maximum corner
+}
+
+func (e *PointNotOnMaxOrMinXYRectangleError) Error() string {
+	return fmt.Sprintf("found point: %#v not sharing a value with min %#v or max %#v", e.Point, e.Min, e.Max)
+}
+
+func (e *PointNotOnMaxOrMinXYRectangleError) Is(target error) bool {
+	return target == ErrNotXYRectangle || target == ErrInvalidPolygon
+}
+
+// TwoMinTwoMaxRequiredError - vertices do not use each minimum and maximum x and y value exactly twice
+type TwoMinTwoMaxRequiredError struct {
+	Vertices []point.Point // vertices provided
+}
+
+func (e *TwoMinTwoMaxRequiredError) Error() string {
+	return fmt.Sprintf("two min and two max x and y values exactly were not for vertices: %#v", e.Vertices)
+}
+
+func (e *TwoMinTwoMaxRequiredError) Is(target error) bool {
+	return target == ErrNotXYRectangle || target == ErrInvalidPolygon
+}
+
+// VertexCountError - a corner of the output rectangle did not match exactly one input vertex
+type VertexCountError struct {
+	Vertex   point.Point // corner of output rectangle
+	HitCount int         // number of input vertices matching the corner
+}
+
+func (e *VertexCountError) Error() string {
+	return fmt.Sprintf("vertex %#v in output rectangle was found %d times in input vertices when it should have appeared once - not a valid XYRectangle", e.Vertex, e.HitCount)
+}
+
+func (e *VertexCountError) Is(target error) bool {
+	return target == ErrNotXYRectangle || target == ErrInvalidPolygon
+}
+
+// DuplicateVertexError - two vertices of a polygon are the same point
+type DuplicateVertexError struct {
+	Index1 int         // index of first vertex
+	Index2 int         // index of second vertex
+	Point  point.Point // the duplicated point
+}
+
+func (e *DuplicateVertexError) Error() string {
+	return fmt.Sprintf("vertices %d and %d are the same point %#v", e.Index1, e.Index2, e.Point)
+}
+
+func (e *DuplicateVertexError) Is(target error) bool {
+	return target == ErrDuplicateVertex || target == ErrInvalidPolygon
+}
+
+// ZeroLengthEdgeError - an edge of a polygon starts and ends at the same point
+type ZeroLengthEdgeError struct {
+	Index int              // index of edge, which starts at the vertex of the same index
+	Edge  line.LineSegment // the zero length edge
+}
+
+func (e *ZeroLengthEdgeError) Error() string {
+	return fmt.Sprintf("edge %d %#v has zero length", e.Index, e.Edge)
+}
+
+func (e *ZeroLengthEdgeError) Is(target error) bool {
+	return target == ErrZeroLengthEdge || target == ErrInvalidPolygon
+}
+
+// CollinearVerticesError - a vertex lies on the straight line through its neighbours
+type CollinearVerticesError struct {
+	Index  int         // index of the middle vertex
+	Prev   point.Point // previous vertex
+	Vertex point.Point // middle vertex
+	Next   point.Point // next vertex
+}
+
+func (e *CollinearVerticesError) Error() string {
+	return fmt.Sprintf("vertex %d %#v is collinear with neighbours %#v and %#v", e.Index, e.Vertex, e.Prev, e.Next)
+}
+
+func (e *CollinearVerticesError) Is(target error) bool {
+	return target == ErrCollinear || target == ErrInvalidPolygon
 }
 
-func IntersectionError(ls1, ls2 line.LineSegment, pt point.Point) error {
-	return fmt.Errorf("polygon lines intersect: line %#v and line %#v intersect at point %#v", ls1, ls2, pt)
+// WindingError - polygon vertices are not in clockwise order, the order used by XYRectangle
+type WindingError struct {
+	SignedArea float32 // signed area of polygon, positive when vertices are counterclockwise
 }
 
-func RectangleDimensionError(dimensions int) error {
-	return fmt.Errorf("four corners required to instantiate this rectangle, the array of points provided was of length %d", dimensions)
+func (e *WindingError) Error() string {
+	return fmt.Sprintf("vertices are not in clockwise order, signed area is %v", e.SignedArea)
 }
 
-func OppositeCornersXYRectangleDimensionError(dimensions int) error {
-	return fmt.Errorf("instantiating rectangle from two opposite corners requires exactly two points to be passed, the array of points was of length %d", dimensions)
+func (e *WindingError) Is(target error) bool {
+	return target == ErrWinding || target == ErrInvalidPolygon
 }
 
-func OppositeCornersXYRectangleSameXError(x float32) error {
-	return fmt.Errorf("both corners passed shared the same x value: %v", x)
+// RepairError - rings split from an outline by Repair that are still not valid polygons
+type RepairError struct {
+	Unrepaired []*XYPolygon // rings that failed validation, clockwise
+	Errs       []error      // validation error of each ring, in the same order
 }
 
-func OppositeCornersXYRectangleSameYError(y float32) error {
-	return fmt.Errorf("both corners passed shared the same y value: %v", y)
+func (e *RepairError) Error() string {
+	return fmt.Sprintf("%d rings could not be repaired, the first because %v", len(e.Unrepaired), e.Errs[0])
 }
 
-func PointsAreTouchingError(a, b point.Point) error {
-	return fmt.Errorf("two of the four points passed are the same point - a: %#v, b: %#v", a, b)
+func (e *RepairError) Is(target error) bool {
+	return target == ErrUnrepaired || target == ErrInvalidPolygon
 }
 
-func SharedMinMaxError(min, max point.Point) error {
-	return fmt.Errorf("min point %#v and max point %#v share and x or y value", min, max)
+// Unwrap - validation errors of the rings, so errors.Is also matches the reasons they failed
+func (e *RepairError) Unwrap() []error {
+	return e.Errs
 }
 
-func PointNotOnMaxOrMinXYRectangleError(p, min, max point.Point) error {
-	return fmt.Errorf("found point: %#v not sharing a value with min %#v or max %#v", p, min, max)
+// NotConvexError - polygon turns the opposite way to the rest of its vertices at a reflex vertex
+type NotConvexError struct {
+	Index  int         // index of reflex vertex
+	Vertex point.Point // reflex vertex
 }
 
-func TwoMinTwoMaxRequiredError(vertices []point.Point) error {
-	return fmt.Errorf("two min and two max x and y values exactly were not for vertices: %#v", vertices)
+func (e *NotConvexError) Error() string {
+	return fmt.Sprintf("polygon is not convex, vertex %d at %v is reflex", e.Index, e.Vertex)
 }
 
-func VertexCountError(vertex point.Point, hitCount int) error {
-	return fmt.Errorf("vertex %#v in output rectangle was found %d times in input vertices when it should have appeared once - not a valid XYRectangle", vertex, hitCount)
+func (e *NotConvexError) Is(target error) bool {
+	// a concave polygon is still a valid polygon, it just cannot be used where convexity is required
+	return target == ErrNotConvex
 }
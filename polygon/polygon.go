@@ -16,13 +16,32 @@ var _ Polygon = &XYPolygon{}
 
 // NewValidatedXYPolygon - returns a pointer to a valid XYPolygon, returns error if not a valid XYPolygon
 func NewValidatedXYPolygon(vertices []point.Point) (*XYPolygon, error) {
+	return newValidatedXYPolygon(vertices, (*XYPolygon).ValidateXYPolygon)
+}
+
+// NewValidatedXYPolygonWithTolerance - returns a pointer to a valid XYPolygon, returns error if not a valid
+// XYPolygon. Edge intersections are decided under the tolerance.
+func NewValidatedXYPolygonWithTolerance(vertices []point.Point, tol point.Tolerance) (*XYPolygon, error) {
+	return newValidatedXYPolygon(vertices, func(p *XYPolygon) (line.LineSegment, line.LineSegment, point.Point, error) {
+		return p.ValidateXYPolygonWithTolerance(tol)
+	})
+}
+
+// NewValidatedXYPolygonRobust - returns a pointer to a valid XYPolygon, returns error if not a valid XYPolygon.
+// Edge intersections are decided with exact predicates.
+func NewValidatedXYPolygonRobust(vertices []point.Point) (*XYPolygon, error) {
+	return newValidatedXYPolygon(vertices, (*XYPolygon).ValidateXYPolygonRobust)
+}
+
+// newValidatedXYPolygon - returns a pointer to an XYPolygon if it passes the validate function provided
+func newValidatedXYPolygon(vertices []point.Point, validate func(*XYPolygon) (line.LineSegment, line.LineSegment, point.Point, error)) (*XYPolygon, error) {
 	order := len(vertices)
 	if order < 3 {
-		return &XYPolygon{}, DimensionError(order)
+		return &XYPolygon{}, &DimensionError{Got: order}
 	}
 	p := &XYPolygon{Vertices: vertices}
 	p.PopulateEdges()
-	_, _, _, err := p.ValidateXYPolygon()
+	_, _, _, err := validate(p)
 	if err != nil {
 		return &XYPolygon{}, err
 	}
@@ -48,10 +67,28 @@ func (p *XYPolygon) ValidatePolygon() error {
 // ValidateXYPolygon - check that XYPolygon is valid, if the polygon self-intersects, the first pair of lines
 // and intersection point found are returned with the error.
 func (p *XYPolygon) ValidateXYPolygon() (segment1, segment2 line.LineSegment, intersectionPoint point.Point, validationErr error) {
+	return p.validateXYPolygon(line.LineSegment.IntersectsLineSegment)
+}
+
+// ValidateXYPolygonWithTolerance - as ValidateXYPolygon, with edge intersections decided under the tolerance.
+func (p *XYPolygon) ValidateXYPolygonWithTolerance(tol point.Tolerance) (segment1, segment2 line.LineSegment, intersectionPoint point.Point, validationErr error) {
+	return p.validateXYPolygon(func(a, b line.LineSegment) (point.Point, bool) {
+		return a.IntersectsLineSegmentWithTolerance(b, tol)
+	})
+}
+
+// ValidateXYPolygonRobust - as ValidateXYPolygon, with edge intersections decided with exact predicates so
+// that near degenerate polygons are never inconsistently accepted or rejected.
+func (p *XYPolygon) ValidateXYPolygonRobust() (segment1, segment2 line.LineSegment, intersectionPoint point.Point, validationErr error) {
+	return p.validateXYPolygon(line.LineSegment.IntersectsLineSegmentRobust)
+}
+
+// validateXYPolygon - check that XYPolygon is valid, using the intersect function provided to test pairs of edges
+func (p *XYPolygon) validateXYPolygon(intersect func(a, b line.LineSegment) (point.Point, bool)) (segment1, segment2 line.LineSegment, intersectionPoint point.Point, validationErr error) {
 	// check dimensions
 	order := len(p.Vertices)
 	if order < 3 {
-		validationErr = DimensionError(order)
+		validationErr = &DimensionError{Got: order}
 		return
 	}
 	if len(p.Edges) != order {
@@ -65,9 +102,9 @@ func (p *XYPolygon) ValidateXYPolygon() (segment1, segment2 line.LineSegment, in
 	// CONSIDER CASE OF THREE POINTS IN A STRAIGHT LINE CONSTRUED AS TRIANGLE
 	for j := 2; j < order-1; j++ {
 		segment2 = p.Edges[j]
-		intersectionPoint, intersects = segment1.IntersectsLineSegment(segment2)
+		intersectionPoint, intersects = intersect(segment1, segment2)
 		if intersects {
-			validationErr = IntersectionError(segment1, segment2, intersectionPoint)
+			validationErr = &SelfIntersectionError{Seg1: segment1, Seg2: segment2, Point: intersectionPoint}
 			return
 		}
 	}
@@ -78,9 +115,9 @@ func (p *XYPolygon) ValidateXYPolygon() (segment1, segment2 line.LineSegment, in
 		// check for intersection: this must occur at shared vertices so do not check next edge.
 		for j := i + 2; j < order; j++ {
 			segment2 = p.Edges[j]
-			intersectionPoint, intersects = segment1.IntersectsLineSegment(segment2)
+			intersectionPoint, intersects = intersect(segment1, segment2)
 			if intersects {
-				validationErr = IntersectionError(segment1, segment2, intersectionPoint)
+				validationErr = &SelfIntersectionError{Seg1: segment1, Seg2: segment2, Point: intersectionPoint}
 				return
 			}
 		}
@@ -88,3 +125,25 @@ func (p *XYPolygon) ValidateXYPolygon() (segment1, segment2 line.LineSegment, in
 	// if here, no intersections outside shared vertices have been found
 	return line.LineSegment{}, line.LineSegment{}, point.Point{}, nil
 }
+
+// SignedArea - area enclosed by polygon, positive if vertices are in counterclockwise order and negative if
+// clockwise. Accumulated in double precision to limit round-off on large polygons.
+func (p *XYPolygon) SignedArea() float32 {
+	return signedArea(p.Vertices)
+}
+
+// IsClockwise - boolean indicating whether polygon vertices are in clockwise order, as used by XYRectangle
+func (p *XYPolygon) IsClockwise() bool {
+	return p.SignedArea() < 0
+}
+
+// signedArea - shoelace formula over a closed ring of vertices
+func signedArea(vertices []point.Point) float32 {
+	order := len(vertices)
+	var area float64
+	for i := 0; i < order; i++ {
+		a, b := vertices[i], vertices[(i+1)%order]
+		area += float64(a.X)*float64(b.Y) - float64(b.X)*float64(a.Y)
+	}
+	return float32(area / 2)
+}
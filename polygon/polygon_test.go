@@ -4,6 +4,7 @@ import (
 	"collision/line"
 	"collision/point"
 	"collision/polygon"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -92,3 +93,49 @@ func TestValidatePolygon(t *testing.T) {
 	assert.Equal(t, line.LineSegment{Start: point.Point{X: 1, Y: 2}, End: point.Point{X: 0, Y: 0}}, ls2, "unexepcted line segment returned")
 	assert.Equal(t, point.Point{X: 0.5, Y: 1}, pt, "unexpected intersection point returned")
 }
+
+// TestValidateXYPolygonWithTolerance - test that tolerance is applied to polygon validation
+func TestValidateXYPolygonWithTolerance(t *testing.T) {
+	// thin spike whose tip nearly touches the opposite edge
+	vertices := []point.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 5, Y: 0.005}, {X: 0, Y: 10}}
+	_, err := polygon.NewValidatedXYPolygon(vertices)
+	assert.Nil(t, err, "polygon should be valid under default delta")
+
+	_, err = polygon.NewValidatedXYPolygonWithTolerance(vertices, point.NewTolerance(0.01, 0.01))
+	assert.NotNil(t, err, "polygon should be invalid under coarse tolerance")
+}
+
+// TestValidateXYPolygonRobust - test that robust validation agrees with expectations on simple input
+func TestValidateXYPolygonRobust(t *testing.T) {
+	c := getTestPoints(10)
+
+	_, err := polygon.NewValidatedXYPolygonRobust([]point.Point{c[0][0], c[1][0], c[1][1], c[0][1]})
+	assert.Nil(t, err, "expect unit square to be validated")
+
+	// bowtie should be rejected
+	p := polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[2][2], c[2][0], c[0][2]}}
+	_, _, pt, err := p.ValidateXYPolygonRobust()
+	assert.NotNil(t, err, "expect bowtie to be rejected")
+	assert.Equal(t, point.Point{X: 1, Y: 1}, pt, "unexpected intersection point returned")
+}
+
+// TestValidationErrorTypes - test that validation errors can be inspected with errors.Is and errors.As
+func TestValidationErrorTypes(t *testing.T) {
+	c := getTestPoints(10)
+
+	_, err := polygon.NewValidatedXYPolygon([]point.Point{c[0][0], c[1][0]})
+	var dimensionErr *polygon.DimensionError
+	assert.True(t, errors.As(err, &dimensionErr), "expect dimension error")
+	assert.Equal(t, 2, dimensionErr.Got, "expect two vertices to be reported")
+	assert.True(t, errors.Is(err, polygon.ErrDimension), "expect dimension sentinel")
+	assert.True(t, errors.Is(err, polygon.ErrInvalidPolygon), "expect invalid polygon sentinel")
+	assert.False(t, errors.Is(err, polygon.ErrSelfIntersection), "dimension error is not a self intersection")
+
+	_, err = polygon.NewValidatedXYPolygon([]point.Point{c[0][1], c[1][1], c[1][2], c[0][0]})
+	var intersectionErr *polygon.SelfIntersectionError
+	assert.True(t, errors.As(err, &intersectionErr), "expect self intersection error")
+	assert.Equal(t, line.LineSegment{Start: c[0][1], End: c[1][1]}, intersectionErr.Seg1, "unexpected first segment")
+	assert.Equal(t, line.LineSegment{Start: c[1][2], End: c[0][0]}, intersectionErr.Seg2, "unexpected second segment")
+	assert.Equal(t, point.Point{X: 0.5, Y: 1}, intersectionErr.Point, "unexpected intersection point")
+	assert.True(t, errors.Is(err, polygon.ErrSelfIntersection), "expect self intersection sentinel")
+}
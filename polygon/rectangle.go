@@ -3,7 +3,6 @@ package polygon
 import (
 	"collision/line"
 	"collision/point"
-	"fmt"
 )
 
 // XYRectangle - defined by four points, this is strictly defined as a rectangle where the edges
@@ -22,7 +21,13 @@ var _ Polygon = &XYRectangle{}
 
 // ValidatePolygon - validate that XYRectangle conforms to required specification
 func (r *XYRectangle) ValidatePolygon() error {
-	return r.validateXYRectangle()
+	return r.validateXYRectangle(point.DefaultTolerance)
+}
+
+// ValidatePolygonWithTolerance - validate that XYRectangle conforms to required specification, comparing
+// coordinates under the tolerance
+func (r *XYRectangle) ValidatePolygonWithTolerance(tol point.Tolerance) error {
+	return r.validateXYRectangle(tol)
 }
 
 // ContainsPoint - boolean indicating whether or not an XYRectangle contains a point
@@ -44,20 +49,52 @@ func (r *XYRectangle) ContainsPoint(p point.Point) bool {
 	}
 }
 
+// ContainsPointWithTolerance - boolean indicating whether or not an XYRectangle contains a point, treating
+// points on an edge under the tolerance as contained
+func (r *XYRectangle) ContainsPointWithTolerance(p point.Point, tol point.Tolerance) bool {
+	if r.ContainsPoint(p) {
+		return true
+	}
+	minX, maxX, minY, maxY, err := point.GetMinMax(r.Vertices[:])
+	if err != nil {
+		return false
+	}
+	withinX := (minX <= p.X && p.X <= maxX) || tol.AreWithin(minX, p.X) || tol.AreWithin(maxX, p.X)
+	withinY := (minY <= p.Y && p.Y <= maxY) || tol.AreWithin(minY, p.Y) || tol.AreWithin(maxY, p.Y)
+	return withinX && withinY
+}
+
 // IntersectsLineSegment - array of intersection points and boolean indicating whether an XYRectangle intersects a line
 func (r *XYRectangle) IntersectsLineSegment(l line.LineSegment) ([]point.Point, bool) {
+	return r.intersectsLineSegment(l, line.LineSegment.IntersectsLineSegment, point.Point.AreTouching)
+}
+
+// IntersectsLineSegmentWithTolerance - as IntersectsLineSegment, with every near equality decision made under
+// the tolerance
+func (r *XYRectangle) IntersectsLineSegmentWithTolerance(l line.LineSegment, tol point.Tolerance) ([]point.Point, bool) {
+	intersect := func(a, b line.LineSegment) (point.Point, bool) {
+		return a.IntersectsLineSegmentWithTolerance(b, tol)
+	}
+	areTouching := func(a, b point.Point) bool {
+		return a.AreTouchingWithTolerance(b, tol)
+	}
+	return r.intersectsLineSegment(l, intersect, areTouching)
+}
+
+// intersectsLineSegment - find de-duplicated intersection points of line with edges of XYRectangle
+func (r *XYRectangle) intersectsLineSegment(l line.LineSegment, intersect func(a, b line.LineSegment) (point.Point, bool), areTouching func(a, b point.Point) bool) ([]point.Point, bool) {
 	hit := false
 	intersections := make([]point.Point, 0, 2) // a line segment touching two vertices would hit all four lines, so de-duplication is required
 	if len(r.Edges) != 4 {
 		r.PopulateEdges()
 	}
 	for _, edge := range r.Edges {
-		newIntersection, ok := edge.IntersectsLineSegment(l)
+		newIntersection, ok := intersect(edge, l)
 		if ok {
 			hit = true
 			duplicate := false
 			for _, recordedIntersection := range intersections {
-				if newIntersection.AreTouching(recordedIntersection) {
+				if areTouching(newIntersection, recordedIntersection) {
 					duplicate = true
 					break
 				}
@@ -84,79 +121,30 @@ func (r *XYRectangle) PopulateEdges() {
 	r.Edges[3] = da
 }
 
-// validateXYRectangle - validate XYRectangle
-func (r *XYRectangle) validateXYRectangle() error {
-	vertices := make([]point.Point, 0, 4)
-	for _, v := range r.Vertices {
-		vertices = append(vertices, v)
-	}
-	if len(vertices) != 4 {
-		RectangleDimensionError(len(vertices))
-	}
-	// loop through points to confirm none are the same point
-	for i := 0; i < 4; i++ {
-		f := vertices[i]
-		for j := i + i; j < 4; j++ {
-			g := vertices[j]
-			if f.AreTouching(g) {
-				PointsAreTouchingError(f, g)
-			}
-		}
-	}
-	// get min and max x and y values
-	minX, maxX, minY, maxY, err := point.GetMinMax(vertices)
-	if err != nil {
-		return err
-	}
-	// loop through vertices to ensure min and max x and y values are only values contained in array
-	// and each value is used twice
-	minPoint := point.Point{X: minX, Y: minY}
-	maxPoint := point.Point{X: maxX, Y: maxY}
-	if minPoint.SameX(maxPoint) || minPoint.SameY(maxPoint) {
-		return SharedMinMaxError(minPoint, maxPoint)
-	}
-	minXCount, maxXCount, minYCount, maxYCount := 0, 0, 0, 0
-	for _, p := range vertices {
-		xfound, yFound := false, false
-		// check x coordinate
-		if p.SameX(minPoint) {
-			xfound = true
-			minXCount++
-		} else if p.SameX(maxPoint) {
-			xfound = true
-			maxXCount++
-		}
-		// check y coordinate
-		if p.SameY(minPoint) {
-			yFound = true
-			minYCount++
-		} else if p.SameY(maxPoint) {
-			yFound = true
-			maxYCount++
-		}
-		if !xfound || !yFound {
-			PointNotOnMaxOrMinXYRectangleError(p, minPoint, maxPoint)
-		}
-	}
-	fmt.Println(minXCount, maxXCount, minYCount, maxYCount)
-	if minXCount != 2 || maxXCount != 2 || minYCount != 2 || maxYCount != 2 {
-		return TwoMinTwoMaxRequiredError(vertices)
-	}
-	return nil
+// validateXYRectangle - validate XYRectangle, comparing coordinates under the tolerance. The first defect
+// found is returned.
+func (r *XYRectangle) validateXYRectangle(tol point.Tolerance) error {
+	return r.report(tol).firstError()
 }
 
 // NewValidatedXYRectangleFrom4Points - returns pointer to validated XYRectangle if points list is valid, error otherwise
 func NewValidatedXYRectangleFrom4Points(vertices []point.Point) (*XYRectangle, error) {
+	return NewValidatedXYRectangleFrom4PointsWithTolerance(vertices, point.DefaultTolerance)
+}
+
+// NewValidatedXYRectangleFrom4PointsWithTolerance - as NewValidatedXYRectangleFrom4Points, comparing
+// coordinates under the tolerance
+func NewValidatedXYRectangleFrom4PointsWithTolerance(vertices []point.Point, tol point.Tolerance) (*XYRectangle, error) {
 	if len(vertices) != 4 {
-		return &XYRectangle{}, RectangleDimensionError(len(vertices))
+		return &XYRectangle{}, &RectangleDimensionError{Got: len(vertices)}
 	}
 	// ensure no vertices are duplicates
 	for i := 0; i < 4; i++ {
 		p1 := vertices[i]
 		for j := i + 1; j < 4; j++ {
 			p2 := vertices[j]
-			if p1.AreTouching(p2) {
-				return &XYRectangle{}, PointsAreTouchingError(p1, p2)
+			if p1.AreTouchingWithTolerance(p2, tol) {
+				return &XYRectangle{}, &PointsAreTouchingError{A: p1, B: p2}
 			}
 		}
 	}
@@ -174,19 +162,19 @@ func NewValidatedXYRectangleFrom4Points(vertices []point.Point) (*XYRectangle, e
 	// each of the four produced vertices must match exactly one of the vertices passed to the function
 	for i, vertexOut := range r.Vertices {
 		for _, vertexIn := range vertices {
-			if vertexOut.AreTouching(vertexIn) {
+			if vertexOut.AreTouchingWithTolerance(vertexIn, tol) {
 				hitCount[i]++
 			}
 		}
 	}
 	for i, count := range hitCount {
 		if count != 1 {
-			return &XYRectangle{}, VertexCountError(r.Vertices[i], count)
+			return &XYRectangle{}, &VertexCountError{Vertex: r.Vertices[i], HitCount: count}
 		}
 	}
 
 	// check this is a valid rectangle
-	err = r.validateXYRectangle()
+	err = r.validateXYRectangle(tol)
 	if err != nil {
 		return &XYRectangle{}, err
 	}
@@ -196,15 +184,21 @@ func NewValidatedXYRectangleFrom4Points(vertices []point.Point) (*XYRectangle, e
 
 // NewValidatedXYRectangleFromOppositeVertices - returns pointer to validated XYRectangle if the two vertices share neither an X or a Y value
 func NewValidatedXYRectangleFromOppositeVertices(vertices []point.Point) (*XYRectangle, error) {
+	return NewValidatedXYRectangleFromOppositeVerticesWithTolerance(vertices, point.DefaultTolerance)
+}
+
+// NewValidatedXYRectangleFromOppositeVerticesWithTolerance - as NewValidatedXYRectangleFromOppositeVertices,
+// comparing coordinates under the tolerance
+func NewValidatedXYRectangleFromOppositeVerticesWithTolerance(vertices []point.Point, tol point.Tolerance) (*XYRectangle, error) {
 	if len(vertices) != 2 {
-		return &XYRectangle{}, OppositeCornersXYRectangleDimensionError(len(vertices))
+		return &XYRectangle{}, &OppositeCornersXYRectangleDimensionError{Got: len(vertices)}
 	}
 	f, g := vertices[0], vertices[1]
-	if f.SameX(g) {
-		return &XYRectangle{}, OppositeCornersXYRectangleSameXError(f.X)
+	if f.SameXWithTolerance(g, tol) {
+		return &XYRectangle{}, &OppositeCornersXYRectangleSameXError{X: f.X}
 	}
-	if f.SameY(g) {
-		return &XYRectangle{}, OppositeCornersXYRectangleSameYError(f.Y)
+	if f.SameYWithTolerance(g, tol) {
+		return &XYRectangle{}, &OppositeCornersXYRectangleSameYError{Y: f.Y}
 	}
 	minX, maxX, minY, maxY, err := point.GetMinMax(vertices)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"collision/line"
 	"collision/point"
 	"collision/polygon"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -171,3 +172,47 @@ func TestIntersectsLineSegment(t *testing.T) {
 	assert.Equal(t, points[1], p[1][4], "expect hit at (1,4)")
 	// FURTHER TEST CASES NEEDED HERE
 }
+
+// TestXYRectangleWithTolerance - test tolerance variants of XYRectangle predicates
+func TestXYRectangleWithTolerance(t *testing.T) {
+	tol := point.NewTolerance(0.01, 0)
+	r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 0, Y: 0}, {X: 10, Y: 10}})
+	assert.Nil(t, err, "no error expected")
+
+	p := point.Point{X: 10.005, Y: 5}
+	assert.False(t, r.ContainsPoint(p), "point should be outside rectangle")
+	assert.True(t, r.ContainsPointWithTolerance(p, tol), "point should be inside rectangle under tolerance")
+
+	_, err = polygon.NewValidatedXYRectangleFromOppositeVerticesWithTolerance([]point.Point{{X: 0, Y: 0}, {X: 0.005, Y: 10}}, tol)
+	assert.EqualError(t, err, "both corners passed shared the same x value: 0", "wrong error encountered")
+
+	ls := line.LineSegment{Start: point.Point{X: 10.05, Y: -5}, End: point.Point{X: 10.05, Y: 15}}
+	_, ok := r.IntersectsLineSegment(ls)
+	assert.False(t, ok, "line should miss rectangle")
+	_, ok = r.IntersectsLineSegmentWithTolerance(ls, point.NewTolerance(0.1, 0))
+	assert.True(t, ok, "line should hit rectangle under tolerance")
+}
+
+// TestXYRectangleErrorTypes - test that rectangle errors can be inspected with errors.Is and errors.As
+func TestXYRectangleErrorTypes(t *testing.T) {
+	p := getTestPoints(4)
+
+	_, err := polygon.NewValidatedXYRectangleFrom4Points([]point.Point{p[0][0], p[0][1], p[0][0], p[1][0]})
+	var touchingErr *polygon.PointsAreTouchingError
+	assert.True(t, errors.As(err, &touchingErr), "expect points are touching error")
+	assert.Equal(t, p[0][0], touchingErr.A, "unexpected duplicate vertex")
+	assert.True(t, errors.Is(err, polygon.ErrDuplicateVertex), "expect duplicate vertex sentinel")
+
+	_, err = polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{p[0][1], p[0][0]})
+	var sameXErr *polygon.OppositeCornersXYRectangleSameXError
+	assert.True(t, errors.As(err, &sameXErr), "expect same x error")
+	assert.Equal(t, float32(0), sameXErr.X, "unexpected shared x value")
+	assert.True(t, errors.Is(err, polygon.ErrNotXYRectangle), "expect not XYRectangle sentinel")
+	assert.True(t, errors.Is(err, polygon.ErrInvalidPolygon), "expect invalid polygon sentinel")
+
+	r := &polygon.XYRectangle{Vertices: [4]point.Point{p[0][0], p[0][1], p[1][1], p[2][0]}}
+	err = r.ValidatePolygon()
+	var notOnMinMaxErr *polygon.PointNotOnMaxOrMinXYRectangleError
+	assert.True(t, errors.As(err, &notOnMinMaxErr), "expect point not on min or max error")
+	assert.Equal(t, p[1][1], notOnMinMaxErr.Point, "unexpected offending vertex")
+}
@@ -0,0 +1,67 @@
+package polygon
+
+import (
+	"collision/point"
+)
+
+// XYPolygonWithHoles - region bounded by an outer polygon with zero or more polygonal holes cut out of it.
+// The outer polygon is clockwise, as XYRectangle, and holes are counterclockwise.
+type XYPolygonWithHoles struct {
+	Outer *XYPolygon   // outer boundary
+	Holes []*XYPolygon // holes, each lying inside the outer boundary
+}
+
+// ContainsPoint - boolean indicating whether a point lies in the region. Points on the outer boundary or on
+// the boundary of a hole are contained.
+func (r *XYPolygonWithHoles) ContainsPoint(p point.Point) bool {
+	if !r.Outer.ContainsPoint(p) {
+		return false
+	}
+	for _, hole := range r.Holes {
+		if hole.ContainsPoint(p) && !hole.boundaryHasPoint(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsPoint - boolean indicating whether a point lies inside or on the boundary of an XYPolygon
+func (p *XYPolygon) ContainsPoint(pt point.Point) bool {
+	if p.boundaryHasPoint(pt) {
+		return true
+	}
+	return windingNumber(p.Vertices, float64(pt.X), float64(pt.Y)) != 0
+}
+
+// boundaryHasPoint - boolean indicating whether point lies on an edge of the polygon
+func (p *XYPolygon) boundaryHasPoint(pt point.Point) bool {
+	if len(p.Edges) != len(p.Vertices) {
+		p.PopulateEdges()
+	}
+	for _, edge := range p.Edges {
+		if edge.HasPoint(pt) {
+			return true
+		}
+	}
+	return false
+}
+
+// windingNumber - number of times a closed ring of vertices winds counterclockwise around the point (x, y)
+func windingNumber(ring []point.Point, x, y float64) int {
+	winding := 0
+	order := len(ring)
+	for i := 0; i < order; i++ {
+		ax, ay := float64(ring[i].X), float64(ring[i].Y)
+		bx, by := float64(ring[(i+1)%order].X), float64(ring[(i+1)%order].Y)
+		// position of point relative to directed edge, positive if on the left
+		isLeft := (bx-ax)*(y-ay) - (x-ax)*(by-ay)
+		if ay <= y {
+			if by > y && isLeft > 0 {
+				winding++
+			}
+		} else if by <= y && isLeft < 0 {
+			winding--
+		}
+	}
+	return winding
+}
@@ -0,0 +1,123 @@
+package polygon
+
+import (
+	"collision/line"
+	"collision/point"
+	"collision/robust"
+)
+
+// Repair - returns valid XYPolygons covering the region outlined by p. Duplicate and collinear vertices are
+// removed, self-intersecting outlines such as bowties are split at each crossing into simple polygons, and
+// every polygon returned is given the clockwise winding used by XYRectangle. Where an outline winds around
+// a region more than once, the polygons returned may overlap. XYPolygon has no holes, so a loop of the outline
+// lying inside the rest of it is returned as a polygon of its own overlapping the one around it, even where the
+// outline winds the opposite way around the loop and so outlines a hole. A DimensionError is returned if fewer
+// than three vertices remain once duplicate and collinear vertices are removed. If any of the rings split from
+// the outline still fails validation, or every ring collapses as the outline is split, the polygons that could
+// be repaired are returned with a *RepairError holding the rings that could not.
+func Repair(p *XYPolygon) ([]*XYPolygon, error) {
+	ring := removeDegenerateVertices(p.Vertices)
+	if len(ring) < 3 {
+		return nil, &DimensionError{Got: len(ring)}
+	}
+	rings := splitRing(ring)
+	if len(rings) == 0 {
+		// nothing with area is left of any loop, so the outline cannot be repaired at all
+		q := clockwisePolygon(ring)
+		_, _, _, err := q.ValidateXYPolygon()
+		if err == nil {
+			err = ErrUnrepaired
+		}
+		return nil, &RepairError{Unrepaired: []*XYPolygon{q}, Errs: []error{err}}
+	}
+	repaired := make([]*XYPolygon, 0, len(rings))
+	var unrepaired *RepairError
+	for _, ring := range rings {
+		q := clockwisePolygon(ring)
+		if _, _, _, err := q.ValidateXYPolygon(); err != nil {
+			if unrepaired == nil {
+				unrepaired = &RepairError{}
+			}
+			unrepaired.Unrepaired = append(unrepaired.Unrepaired, q)
+			unrepaired.Errs = append(unrepaired.Errs, err)
+			continue
+		}
+		repaired = append(repaired, q)
+	}
+	if unrepaired != nil {
+		return repaired, unrepaired
+	}
+	return repaired, nil
+}
+
+// clockwisePolygon - XYPolygon with the vertices of a ring, reversed in place if needed to wind clockwise
+func clockwisePolygon(ring []point.Point) *XYPolygon {
+	if signedArea(ring) > 0 {
+		reverseRing(ring)
+	}
+	q := &XYPolygon{Vertices: ring}
+	q.PopulateEdges()
+	return q
+}
+
+// removeDegenerateVertices - returns a copy of a ring of vertices with consecutive duplicates and vertices
+// collinear with their neighbours removed, repeating until no more can be removed
+func removeDegenerateVertices(vertices []point.Point) []point.Point {
+	ring := append([]point.Point{}, vertices...)
+	for removed := true; removed && len(ring) > 0; {
+		removed = false
+		for i := 0; i < len(ring) && len(ring) > 0; i++ {
+			order := len(ring)
+			prev, vertex, next := ring[(i+order-1)%order], ring[i], ring[(i+1)%order]
+			if vertex.AreTouching(next) || robust.Orient(prev, vertex, next) == robust.Collinear {
+				ring = append(ring[:i], ring[i+1:]...)
+				removed = true
+				i--
+			}
+		}
+		if len(ring) < 3 {
+			return ring[:0]
+		}
+	}
+	return ring
+}
+
+// splitRing - split a ring of vertices at each point where two non-adjacent edges meet, returning simple rings.
+// Each split produces two rings with strictly fewer vertices than the ring split, so this terminates.
+func splitRing(ring []point.Point) [][]point.Point {
+	order := len(ring)
+	if order < 3 {
+		return nil
+	}
+	for i := 0; i < order; i++ {
+		edgeI := line.LineSegment{Start: ring[i], End: ring[(i+1)%order]}
+		for j := i + 2; j < order; j++ {
+			if i == 0 && j == order-1 {
+				// first and last edges share first vertex
+				continue
+			}
+			edgeJ := line.LineSegment{Start: ring[j], End: ring[(j+1)%order]}
+			crossing, ok := edgeI.IntersectsLineSegment(edgeJ)
+			if !ok {
+				continue
+			}
+			// ring from crossing along edge i to the start of edge j, and from crossing along edge j back to edge i
+			first := append([]point.Point{crossing}, ring[i+1:j+1]...)
+			second := append([]point.Point{crossing}, ring[j+1:]...)
+			second = append(second, ring[:i+1]...)
+			var rings [][]point.Point
+			for _, r := range [][]point.Point{first, second} {
+				rings = append(rings, splitRing(removeDegenerateVertices(r))...)
+			}
+			return rings
+		}
+	}
+	return [][]point.Point{ring}
+}
+
+// reverseRing - reverse order of vertices in place
+func reverseRing(ring []point.Point) {
+	for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+		ring[i], ring[j] = ring[j], ring[i]
+	}
+}
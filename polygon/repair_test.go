@@ -0,0 +1,86 @@
+package polygon_test
+
+import (
+	"collision/point"
+	"collision/polygon"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRepair - test that Repair returns valid polygons for broken outlines
+func TestRepair(t *testing.T) {
+	c := getTestPoints(10)
+
+	// valid counterclockwise square with a duplicate and a collinear vertex
+	p := &polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[1][0], c[2][0], c[2][0], c[2][2], c[0][2]}}
+	repaired, err := polygon.Repair(p)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 1, len(repaired), "expect single polygon")
+	assert.Equal(t, []point.Point{c[0][2], c[2][2], c[2][0], c[0][0]}, repaired[0].Vertices, "expect clockwise square without redundant vertices")
+
+	// bowtie should split into two clockwise triangles
+	p = &polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[2][2], c[2][0], c[0][2]}}
+	repaired, err = polygon.Repair(p)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 2, len(repaired), "expect two triangles")
+	totalArea := float32(0)
+	for _, q := range repaired {
+		assert.True(t, q.Validate().Valid(), "expect repaired polygon to be valid")
+		assert.True(t, q.IsClockwise(), "expect repaired polygon to be clockwise")
+		assert.Equal(t, 3, len(q.Vertices), "expect triangle")
+		totalArea += q.SignedArea()
+	}
+	assert.Equal(t, float32(-2), totalArea, "expect triangles to cover bowtie")
+
+	// double bowtie should split into three polygons
+	p = &polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[0][2], c[2][0], c[4][2], c[4][0], c[2][2]}}
+	repaired, err = polygon.Repair(p)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 3, len(repaired), "expect three polygons")
+	for _, q := range repaired {
+		assert.True(t, q.Validate().Valid(), "expect repaired polygon to be valid")
+	}
+
+	// collinear points cannot be repaired
+	p = &polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[1][1], c[2][2], c[3][3]}}
+	_, err = polygon.Repair(p)
+	assert.True(t, errors.Is(err, polygon.ErrDimension), "expect dimension error")
+}
+
+// TestRepairUnrepaired - test that a piece of a split outline failing validation is returned with an error
+// rather than dropped
+func TestRepairUnrepaired(t *testing.T) {
+	// bowtie with vertices within round-off of each other's edges, whose crossing point rounded to float32 leaves
+	// one piece self-intersecting
+	p := &polygon.XYPolygon{Vertices: []point.Point{{X: 1, Y: 4}, {X: 2, Y: 0}, {X: 2.999999, Y: 2}, {X: 0.999999, Y: 0}, {X: 2, Y: 3e-7}}}
+	repaired, err := polygon.Repair(p)
+	assert.True(t, errors.Is(err, polygon.ErrUnrepaired), "expect repair error")
+	assert.True(t, errors.Is(err, polygon.ErrSelfIntersection), "expect reason piece failed")
+	assert.Equal(t, 1, len(repaired), "expect piece that could be repaired")
+	for _, q := range repaired {
+		assert.True(t, q.Validate().Valid(), "expect repaired polygon to be valid")
+	}
+	var repairErr *polygon.RepairError
+	if assert.True(t, errors.As(err, &repairErr)) {
+		assert.Equal(t, 1, len(repairErr.Unrepaired), "expect piece that could not be repaired")
+		assert.Equal(t, 1, len(repairErr.Errs))
+		assert.True(t, repairErr.Unrepaired[0].IsClockwise())
+	}
+}
+
+// TestRepairInnerLoop - test that a loop of an outline lying inside the rest of it is returned as a polygon of its
+// own overlapping the one around it, since XYPolygon has no holes, even where the loop outlines a hole
+func TestRepairInnerLoop(t *testing.T) {
+	// square touching itself at the middle of its bottom edge, with a triangular loop wound the opposite way
+	p := &polygon.XYPolygon{Vertices: []point.Point{{X: 0, Y: 0}, {X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 0}}}
+	repaired, err := polygon.Repair(p)
+	assert.Nil(t, err, "no error expected")
+	if assert.Equal(t, 2, len(repaired), "expect square and triangle") {
+		triangle, square := repaired[0], repaired[1]
+		assert.Equal(t, []point.Point{{X: 1, Y: 1}, {X: 3, Y: 1}, {X: 2, Y: 0}}, triangle.Vertices, "expect clockwise triangle")
+		assert.Equal(t, []point.Point{{X: 0, Y: 0}, {X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 0}}, square.Vertices, "expect clockwise square")
+		assert.Equal(t, float32(-17), triangle.SignedArea()+square.SignedArea(), "expect triangle to be added to square rather than cut out of it")
+	}
+}
@@ -0,0 +1,231 @@
+package polygon
+
+import (
+	"collision/line"
+	"collision/point"
+	"math"
+)
+
+// SimplifyPolylineRDP - simplify an open chain of points with the Ramer–Douglas–Peucker algorithm. Points
+// deviating from the simplified chain by no more than tolerance are removed, the first and last points are
+// always kept, and no new self-intersections are introduced.
+func SimplifyPolylineRDP(points []point.Point, tolerance float32) []point.Point {
+	if len(points) < 3 {
+		return append([]point.Point{}, points...)
+	}
+	kept := simplifyRDP(points, tolerance, false, 2)
+	return keptPoints(points, kept)
+}
+
+// SimplifyPolylineVW - simplify an open chain of points with the Visvalingam–Whyatt algorithm. Points whose
+// triangle with their neighbours has an area below tolerance are removed, smallest first. The first and last
+// points are always kept, and no new self-intersections are introduced.
+func SimplifyPolylineVW(points []point.Point, tolerance float32) []point.Point {
+	if len(points) < 3 {
+		return append([]point.Point{}, points...)
+	}
+	kept := simplifyVW(points, tolerance, false)
+	return keptPoints(points, kept)
+}
+
+// SimplifyRDP - returns a simplified copy of the polygon using the Ramer–Douglas–Peucker algorithm with the
+// distance tolerance provided. Topology is preserved: the result still passes ValidateXYPolygon. The polygon
+// must be valid to begin with.
+func (p *XYPolygon) SimplifyRDP(tolerance float32) (*XYPolygon, error) {
+	if _, _, _, err := p.ValidateXYPolygon(); err != nil {
+		return &XYPolygon{}, err
+	}
+	// close ring by repeating first vertex, so it can be treated as a chain
+	ring := append(append([]point.Point{}, p.Vertices...), p.Vertices[0])
+	kept := simplifyRDP(ring, tolerance, true, 3)
+	return NewValidatedXYPolygon(keptPoints(ring[:len(ring)-1], kept[:len(kept)-1]))
+}
+
+// SimplifyVW - returns a simplified copy of the polygon using the Visvalingam–Whyatt algorithm with the area
+// tolerance provided. Topology is preserved: the result still passes ValidateXYPolygon. The polygon must be
+// valid to begin with.
+func (p *XYPolygon) SimplifyVW(tolerance float32) (*XYPolygon, error) {
+	if _, _, _, err := p.ValidateXYPolygon(); err != nil {
+		return &XYPolygon{}, err
+	}
+	kept := simplifyVW(p.Vertices, tolerance, true)
+	return NewValidatedXYPolygon(keptPoints(p.Vertices, kept))
+}
+
+// simplifyRDP - mark points kept by Ramer–Douglas–Peucker. For a closed ring the last point repeats the first.
+// At least minimum distinct points are kept, and spans whose new edge would cross the rest of the chain are
+// subdivided until it does not.
+func simplifyRDP(points []point.Point, tolerance float32, closed bool, minimum int) []bool {
+	last := len(points) - 1
+	kept := make([]bool, len(points))
+	kept[0], kept[last] = true, true
+	if closed {
+		// split ring at the vertex furthest from the first, so each half is a chain with distinct ends
+		far := 0
+		for i := 1; i < last; i++ {
+			if points[0].Distance(points[i]) > points[0].Distance(points[far]) {
+				far = i
+			}
+		}
+		kept[far] = true
+		rdp(points, kept, 0, far, tolerance)
+		rdp(points, kept, far, last, tolerance)
+	} else {
+		rdp(points, kept, 0, last, tolerance)
+	}
+
+	for {
+		changed := false
+		indices := keptIndices(kept)
+		distinct := len(indices)
+		if closed {
+			distinct--
+		}
+		for k := 0; k+1 < len(indices); k++ {
+			a, b := indices[k], indices[k+1]
+			if b-a < 2 {
+				continue
+			}
+			if distinct < minimum || crossesChain(points, indices, k, closed) {
+				kept[furthestFromSpan(points, a, b)] = true
+				changed = true
+				distinct++
+			}
+		}
+		if !changed {
+			return kept
+		}
+	}
+}
+
+// rdp - recursive step of Ramer–Douglas–Peucker between kept points lo and hi
+func rdp(points []point.Point, kept []bool, lo, hi int, tolerance float32) {
+	if hi-lo < 2 {
+		return
+	}
+	furthest := furthestFromSpan(points, lo, hi)
+	span := line.LineSegment{Start: points[lo], End: points[hi]}
+	if distanceToSegment(points[furthest], span) <= float64(tolerance) {
+		return
+	}
+	kept[furthest] = true
+	rdp(points, kept, lo, furthest, tolerance)
+	rdp(points, kept, furthest, hi, tolerance)
+}
+
+// simplifyVW - mark points kept by Visvalingam–Whyatt. Points are removed in order of increasing triangle area
+// while that area is below tolerance. A removal that would make the new edge cross the chain is skipped.
+func simplifyVW(points []point.Point, tolerance float32, closed bool) []bool {
+	order := len(points)
+	kept := make([]bool, order)
+	locked := make([]bool, order)
+	for i := range kept {
+		kept[i] = true
+	}
+	minimum := 2
+	if closed {
+		minimum = 3
+	} else {
+		locked[0], locked[order-1] = true, true
+	}
+	remaining := order
+	for remaining > minimum {
+		indices := keptIndices(kept)
+		best, bestArea := -1, float64(tolerance)
+		for k, i := range indices {
+			if locked[i] {
+				continue
+			}
+			prev, next := indices[(k+len(indices)-1)%len(indices)], indices[(k+1)%len(indices)]
+			area := math.Abs(float64(signedArea([]point.Point{points[prev], points[i], points[next]})))
+			if area < bestArea {
+				best, bestArea = k, area
+			}
+		}
+		if best < 0 {
+			return kept
+		}
+		// check that the edge replacing the removed point does not cross the rest of the chain
+		candidate := append(append([]int{}, indices[:best]...), indices[best+1:]...)
+		if closed {
+			candidate = append(candidate, candidate[0])
+		}
+		k := best - 1
+		if k < 0 {
+			k = len(candidate) - 2
+		}
+		if crossesChain(points, candidate, k, closed) {
+			locked[indices[best]] = true
+			continue
+		}
+		kept[indices[best]] = false
+		remaining--
+	}
+	return kept
+}
+
+// crossesChain - boolean indicating whether edge k of the chain through indices meets any non-adjacent edge.
+// For a closed chain the last index repeats the first.
+func crossesChain(points []point.Point, indices []int, k int, closed bool) bool {
+	edges := len(indices) - 1
+	edge := line.LineSegment{Start: points[indices[k]], End: points[indices[k+1]]}
+	for m := 0; m < edges; m++ {
+		if m == k || m == k-1 || m == k+1 {
+			continue
+		}
+		if closed && ((k == 0 && m == edges-1) || (k == edges-1 && m == 0)) {
+			continue
+		}
+		other := line.LineSegment{Start: points[indices[m]], End: points[indices[m+1]]}
+		if _, ok := edge.IntersectsLineSegment(other); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// furthestFromSpan - index of the point strictly between a and b furthest from the segment joining them
+func furthestFromSpan(points []point.Point, a, b int) int {
+	span := line.LineSegment{Start: points[a], End: points[b]}
+	furthest, furthestDistance := a+1, -1.0
+	for i := a + 1; i < b; i++ {
+		if d := distanceToSegment(points[i], span); d > furthestDistance {
+			furthest, furthestDistance = i, d
+		}
+	}
+	return furthest
+}
+
+// distanceToSegment - distance from point to nearest point of segment, in double precision
+func distanceToSegment(p point.Point, ls line.LineSegment) float64 {
+	dx, dy := float64(ls.End.X)-float64(ls.Start.X), float64(ls.End.Y)-float64(ls.Start.Y)
+	px, py := float64(p.X)-float64(ls.Start.X), float64(p.Y)-float64(ls.Start.Y)
+	lengthSquared := dx*dx + dy*dy
+	t := 0.0
+	if lengthSquared > 0 {
+		t = math.Max(0, math.Min(1, (px*dx+py*dy)/lengthSquared))
+	}
+	return math.Hypot(px-t*dx, py-t*dy)
+}
+
+// keptIndices - indices of kept points in order
+func keptIndices(kept []bool) []int {
+	indices := make([]int, 0, len(kept))
+	for i, k := range kept {
+		if k {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// keptPoints - kept points in order
+func keptPoints(points []point.Point, kept []bool) []point.Point {
+	out := make([]point.Point, 0, len(points))
+	for i, k := range kept {
+		if k {
+			out = append(out, points[i])
+		}
+	}
+	return out
+}
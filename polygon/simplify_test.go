@@ -0,0 +1,83 @@
+package polygon_test
+
+import (
+	"collision/point"
+	"collision/polygon"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// noisyCircle - returns a clockwise traced outline of a circle with small wobbles
+func noisyCircle(size int, radius float32) []point.Point {
+	vertices := make([]point.Point, size)
+	for i := 0; i < size; i++ {
+		angle := -2 * math.Pi * float64(i) / float64(size)
+		r := float64(radius) + 0.05*math.Sin(float64(i)*7)
+		vertices[i] = point.Point{X: float32(r * math.Cos(angle)), Y: float32(r * math.Sin(angle))}
+	}
+	return vertices
+}
+
+// TestSimplifyPolyline - test that polyline simplification behaves as expected
+func TestSimplifyPolyline(t *testing.T) {
+	points := []point.Point{{X: 0, Y: 0}, {X: 1, Y: 0.01}, {X: 2, Y: -0.01}, {X: 3, Y: 0}, {X: 3, Y: 5}}
+
+	simplified := polygon.SimplifyPolylineRDP(points, 0.1)
+	assert.Equal(t, []point.Point{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 5}}, simplified, "expect wobble to be removed")
+
+	simplified = polygon.SimplifyPolylineVW(points, 0.1)
+	assert.Equal(t, []point.Point{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 5}}, simplified, "expect wobble to be removed")
+
+	simplified = polygon.SimplifyPolylineRDP(points, 0.001)
+	assert.Equal(t, points, simplified, "expect nothing removed")
+
+	// removing the shallow bump would leave a chord crossed by the later part of the chain
+	hook := []point.Point{{X: 0, Y: 0}, {X: 5, Y: 1}, {X: 10, Y: 0}, {X: 10, Y: -10}, {X: 5, Y: 0.5}, {X: 0, Y: -10}}
+	simplified = polygon.SimplifyPolylineRDP(hook, 2)
+	assert.Equal(t, hook, simplified, "expect bump kept to avoid crossing")
+	simplified = polygon.SimplifyPolylineVW(hook, 10)
+	assert.Contains(t, simplified, point.Point{X: 5, Y: 1}, "expect bump kept to avoid crossing")
+}
+
+// TestSimplifyXYPolygon - test that polygon simplification reduces vertices and preserves validity
+func TestSimplifyXYPolygon(t *testing.T) {
+	p, err := polygon.NewValidatedXYPolygon(noisyCircle(400, 10))
+	assert.Nil(t, err, "traced circle should be valid")
+
+	simplified, err := p.SimplifyRDP(0.2)
+	assert.Nil(t, err, "no error expected")
+	assert.Less(t, len(simplified.Vertices), 60, "expect far fewer vertices")
+	assert.GreaterOrEqual(t, len(simplified.Vertices), 3, "expect at least a triangle")
+	assert.True(t, simplified.Validate().Valid(), "expect simplified polygon to be valid")
+
+	simplified, err = p.SimplifyVW(0.5)
+	assert.Nil(t, err, "no error expected")
+	assert.Less(t, len(simplified.Vertices), 100, "expect far fewer vertices")
+	assert.True(t, simplified.Validate().Valid(), "expect simplified polygon to be valid")
+
+	// huge tolerance still leaves a valid triangle
+	simplified, err = p.SimplifyRDP(1000)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 3, len(simplified.Vertices), "expect triangle")
+	simplified, err = p.SimplifyVW(100000)
+	assert.Nil(t, err, "no error expected")
+	assert.Equal(t, 3, len(simplified.Vertices), "expect triangle")
+
+	// narrow channel: simplifying either wall on its own would cut through the other
+	c := []point.Point{{X: 0, Y: 0}, {X: 0, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 5.2}, {X: 5, Y: 4.9}, {X: 1, Y: 5.2}, {X: 1, Y: 4.8}, {X: 5, Y: 4.5}, {X: 10, Y: 4.8}, {X: 10, Y: 0}}
+	p, err = polygon.NewValidatedXYPolygon(c)
+	assert.Nil(t, err, "channel should be valid")
+	simplified, err = p.SimplifyRDP(1)
+	assert.Nil(t, err, "no error expected")
+	assert.True(t, simplified.Validate().Valid(), "expect simplified channel to be valid")
+	simplified, err = p.SimplifyVW(2)
+	assert.Nil(t, err, "no error expected")
+	assert.True(t, simplified.Validate().Valid(), "expect simplified channel to be valid")
+
+	// invalid polygon cannot be simplified
+	p = &polygon.XYPolygon{Vertices: []point.Point{{X: 0, Y: 0}, {X: 2, Y: 2}, {X: 2, Y: 0}, {X: 0, Y: 2}}}
+	_, err = p.SimplifyRDP(1)
+	assert.NotNil(t, err, "expect bowtie to be rejected")
+}
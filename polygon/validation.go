@@ -0,0 +1,263 @@
+package polygon
+
+import (
+	"collision/line"
+	"collision/point"
+	"collision/robust"
+	"errors"
+)
+
+// Severity - how serious a validation defect is
+type Severity int
+
+const (
+	SeverityWarning Severity = iota // polygon is usable, but may behave unexpectedly
+	SeverityError                   // polygon is not valid
+)
+
+// String - name of severity
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Defect - a single problem found by validation. Err is one of the typed errors in this package, so
+// errors.As can be used to read the offending edges and vertices.
+type Defect struct {
+	Severity Severity // seriousness of defect
+	Err      error    // typed error describing defect
+}
+
+// ValidationReport - every defect found when validating a polygon, in the order found
+type ValidationReport struct {
+	Defects []Defect // defects found
+}
+
+// Valid - boolean indicating whether the report contains no defects of error severity
+func (vr ValidationReport) Valid() bool {
+	return vr.firstError() == nil
+}
+
+// Err - all defects of error severity joined into a single error, nil if there are none
+func (vr ValidationReport) Err() error {
+	var errs []error
+	for _, d := range vr.Defects {
+		if d.Severity == SeverityError {
+			errs = append(errs, d.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// add - record a defect
+func (vr *ValidationReport) add(severity Severity, err error) {
+	vr.Defects = append(vr.Defects, Defect{Severity: severity, Err: err})
+}
+
+// firstError - first defect of error severity found, nil if there are none
+func (vr ValidationReport) firstError() error {
+	for _, d := range vr.Defects {
+		if d.Severity == SeverityError {
+			return d.Err
+		}
+	}
+	return nil
+}
+
+// Validate - check XYPolygon for every defect rather than stopping at the first: each crossing pair of edges,
+// duplicate vertex, collinear triple, zero length edge and wrong winding is reported.
+func (p *XYPolygon) Validate() ValidationReport {
+	return p.validate(line.LineSegment.IntersectsLineSegment, point.Point.AreTouching, exactlyCollinear)
+}
+
+// ValidateWithTolerance - as Validate, with every near equality decision made under the tolerance
+func (p *XYPolygon) ValidateWithTolerance(tol point.Tolerance) ValidationReport {
+	intersect := func(a, b line.LineSegment) (point.Point, bool) {
+		return a.IntersectsLineSegmentWithTolerance(b, tol)
+	}
+	areTouching := func(a, b point.Point) bool {
+		return a.AreTouchingWithTolerance(b, tol)
+	}
+	collinear := func(prev, vertex, next point.Point) bool {
+		return vertex.AreTouchingWithTolerance(closestOnLine(prev, next, vertex), tol)
+	}
+	return p.validate(intersect, areTouching, collinear)
+}
+
+// exactlyCollinear - boolean indicating whether three points lie exactly on one line
+func exactlyCollinear(prev, vertex, next point.Point) bool {
+	return robust.Orient(prev, vertex, next) == robust.Collinear
+}
+
+// closestOnLine - point nearest to p on the infinite line through a and b, which are distinct
+func closestOnLine(a, b, p point.Point) point.Point {
+	abx, aby := float64(b.X)-float64(a.X), float64(b.Y)-float64(a.Y)
+	apx, apy := float64(p.X)-float64(a.X), float64(p.Y)-float64(a.Y)
+	t := (apx*abx + apy*aby) / (abx*abx + aby*aby)
+	return point.Point{X: float32(float64(a.X) + t*abx), Y: float32(float64(a.Y) + t*aby)}
+}
+
+// doublesBack - boolean indicating whether a ring through three collinear points turns back on itself at the
+// middle one, so that the edges either side of it overlap
+func doublesBack(prev, vertex, next point.Point) bool {
+	toPrevX, toPrevY := float64(prev.X)-float64(vertex.X), float64(prev.Y)-float64(vertex.Y)
+	toNextX, toNextY := float64(next.X)-float64(vertex.X), float64(next.Y)-float64(vertex.Y)
+	return toPrevX*toNextX+toPrevY*toNextY > 0
+}
+
+// nearer - whichever of a and b is nearer to p
+func nearer(p, a, b point.Point) point.Point {
+	if p.Distance(a) <= p.Distance(b) {
+		return a
+	}
+	return b
+}
+
+// validate - build validation report using the predicates provided
+func (p *XYPolygon) validate(intersect func(a, b line.LineSegment) (point.Point, bool), areTouching func(a, b point.Point) bool, collinear func(prev, vertex, next point.Point) bool) ValidationReport {
+	var report ValidationReport
+	order := len(p.Vertices)
+	if order < 3 {
+		report.add(SeverityError, &DimensionError{Got: order})
+		return report
+	}
+	if len(p.Edges) != order {
+		p.PopulateEdges()
+	}
+
+	// zero length edges join consecutive vertices, any other pair of touching vertices is a duplicate
+	var degenerate []point.Point
+	for i := 0; i < order; i++ {
+		for j := i + 1; j < order; j++ {
+			if !areTouching(p.Vertices[i], p.Vertices[j]) {
+				continue
+			}
+			if j == i+1 {
+				report.add(SeverityError, &ZeroLengthEdgeError{Index: i, Edge: p.Edges[i]})
+			} else if i == 0 && j == order-1 {
+				report.add(SeverityError, &ZeroLengthEdgeError{Index: j, Edge: p.Edges[j]})
+			} else {
+				report.add(SeverityError, &DuplicateVertexError{Index1: i, Index2: j, Point: p.Vertices[i]})
+			}
+			degenerate = append(degenerate, p.Vertices[i])
+		}
+	}
+
+	// collinear triples of consecutive vertices, which are an error where the ring doubles back on itself in a
+	// zero area spike, since the edges either side of the vertex then overlap
+	for i := 0; i < order; i++ {
+		prev, vertex, next := p.Vertices[(i+order-1)%order], p.Vertices[i], p.Vertices[(i+1)%order]
+		if areTouching(prev, vertex) || areTouching(vertex, next) || !collinear(prev, vertex, next) {
+			continue
+		}
+		if doublesBack(prev, vertex, next) {
+			// the overlap runs from the vertex to whichever neighbour is nearer
+			report.add(SeverityError, &SelfIntersectionError{Seg1: p.Edges[(i+order-1)%order], Seg2: p.Edges[i], Point: nearer(vertex, prev, next)})
+		} else {
+			report.add(SeverityWarning, &CollinearVerticesError{Index: i, Prev: prev, Vertex: vertex, Next: next})
+		}
+	}
+
+	// every crossing pair of non-adjacent edges
+	for i := 0; i < order; i++ {
+		for j := i + 2; j < order; j++ {
+			if i == 0 && j == order-1 {
+				// first and last edges share first vertex
+				continue
+			}
+			if intersectionPoint, ok := intersect(p.Edges[i], p.Edges[j]); ok {
+				if meetAtDegenerateVertex(p.Edges[i], p.Edges[j], intersectionPoint, degenerate, areTouching) {
+					// already reported as a zero length edge or duplicate vertex
+					continue
+				}
+				report.add(SeverityError, &SelfIntersectionError{Seg1: p.Edges[i], Seg2: p.Edges[j], Point: intersectionPoint})
+			}
+		}
+	}
+
+	// clockwise winding, to match XYRectangle
+	if area := p.SignedArea(); area > 0 {
+		report.add(SeverityWarning, &WindingError{SignedArea: area})
+	}
+	return report
+}
+
+// meetAtDegenerateVertex - boolean indicating whether two edges meet only at an end of each that is one of the
+// degenerate vertices
+func meetAtDegenerateVertex(a, b line.LineSegment, at point.Point, degenerate []point.Point, areTouching func(a, b point.Point) bool) bool {
+	isEnd := func(ls line.LineSegment, v point.Point) bool {
+		return areTouching(ls.Start, v) || areTouching(ls.End, v)
+	}
+	for _, v := range degenerate {
+		if areTouching(at, v) && isEnd(a, v) && isEnd(b, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate - check XYRectangle for every defect rather than stopping at the first
+func (r *XYRectangle) Validate() ValidationReport {
+	return r.report(point.DefaultTolerance)
+}
+
+// ValidateWithTolerance - as Validate, comparing coordinates under the tolerance
+func (r *XYRectangle) ValidateWithTolerance(tol point.Tolerance) ValidationReport {
+	return r.report(tol)
+}
+
+// report - build validation report for XYRectangle, comparing coordinates under the tolerance
+func (r *XYRectangle) report(tol point.Tolerance) ValidationReport {
+	var report ValidationReport
+	vertices := r.Vertices[:]
+	// loop through points to confirm none are the same point
+	for i := 0; i < 4; i++ {
+		f := vertices[i]
+		for j := i + 1; j < 4; j++ {
+			g := vertices[j]
+			if f.AreTouchingWithTolerance(g, tol) {
+				report.add(SeverityError, &PointsAreTouchingError{A: f, B: g})
+			}
+		}
+	}
+	// get min and max x and y values
+	minX, maxX, minY, maxY, _ := point.GetMinMax(vertices)
+	minPoint := point.Point{X: minX, Y: minY}
+	maxPoint := point.Point{X: maxX, Y: maxY}
+	if minPoint.SameXWithTolerance(maxPoint, tol) || minPoint.SameYWithTolerance(maxPoint, tol) {
+		// remaining checks count vertices on min and max, which is meaningless if they coincide
+		report.add(SeverityError, &SharedMinMaxError{Min: minPoint, Max: maxPoint})
+		return report
+	}
+	// loop through vertices to ensure min and max x and y values are only values contained in array
+	// and each value is used twice
+	minXCount, maxXCount, minYCount, maxYCount := 0, 0, 0, 0
+	for _, p := range vertices {
+		xfound, yFound := false, false
+		// check x coordinate
+		if p.SameXWithTolerance(minPoint, tol) {
+			xfound = true
+			minXCount++
+		} else if p.SameXWithTolerance(maxPoint, tol) {
+			xfound = true
+			maxXCount++
+		}
+		// check y coordinate
+		if p.SameYWithTolerance(minPoint, tol) {
+			yFound = true
+			minYCount++
+		} else if p.SameYWithTolerance(maxPoint, tol) {
+			yFound = true
+			maxYCount++
+		}
+		if !xfound || !yFound {
+			report.add(SeverityError, &PointNotOnMaxOrMinXYRectangleError{Point: p, Min: minPoint, Max: maxPoint})
+		}
+	}
+	if minXCount != 2 || maxXCount != 2 || minYCount != 2 || maxYCount != 2 {
+		report.add(SeverityError, &TwoMinTwoMaxRequiredError{Vertices: append([]point.Point{}, vertices...)})
+	}
+	return report
+}
@@ -0,0 +1,130 @@
+package polygon_test
+
+import (
+	"collision/point"
+	"collision/polygon"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countDefects - count defects in report matching the sentinel
+func countDefects(report polygon.ValidationReport, target error) int {
+	count := 0
+	for _, d := range report.Defects {
+		if errors.Is(d.Err, target) {
+			count++
+		}
+	}
+	return count
+}
+
+// TestValidate - test that Validate reports every defect of an XYPolygon
+func TestValidate(t *testing.T) {
+	c := getTestPoints(10)
+
+	// clockwise unit square should have no defects
+	p := polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[0][1], c[1][1], c[1][0]}}
+	report := p.Validate()
+	assert.True(t, report.Valid(), "expect unit square to be valid")
+	assert.Empty(t, report.Defects, "expect no defects")
+	assert.Nil(t, report.Err(), "expect no error")
+
+	// counterclockwise square is valid, but has a winding warning
+	p = polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[1][0], c[1][1], c[0][1]}}
+	report = p.Validate()
+	assert.True(t, report.Valid(), "expect counterclockwise square to be valid")
+	assert.Equal(t, 1, countDefects(report, polygon.ErrWinding), "expect winding warning")
+	assert.Equal(t, polygon.SeverityWarning, report.Defects[0].Severity, "expect winding to be a warning")
+
+	// square with a collinear vertex on one edge
+	p = polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[0][1], c[0][2], c[2][2], c[2][0]}}
+	report = p.Validate()
+	assert.True(t, report.Valid(), "expect collinear vertex to be a warning")
+	var collinearErr *polygon.CollinearVerticesError
+	assert.True(t, errors.As(report.Defects[0].Err, &collinearErr), "expect collinear vertices")
+	assert.Equal(t, 1, collinearErr.Index, "expect middle vertex of left edge")
+
+	// zero area spike, which doubles back at both of its ends, so the edges meeting there overlap
+	p = polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[2][0], c[1][0]}}
+	report = p.Validate()
+	assert.False(t, report.Valid(), "expect spike to be invalid")
+	assert.Equal(t, 2, countDefects(report, polygon.ErrSelfIntersection), "expect overlapping edges at both ends")
+	assert.Equal(t, 1, countDefects(report, polygon.ErrCollinear), "expect middle vertex to be collinear")
+	var overlapErr *polygon.SelfIntersectionError
+	assert.True(t, errors.As(report.Err(), &overlapErr), "expect overlapping edges")
+	assert.Equal(t, c[1][0], overlapErr.Point, "expect overlap to end at nearer neighbour")
+
+	// double bowtie with a repeated vertex has two crossings and a zero length edge. The edges either side of
+	// the zero length edge meet at the repeated vertex, which is not reported again as a crossing.
+	p = polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[0][2], c[2][0], c[2][0], c[4][2], c[4][0], c[2][2]}}
+	report = p.Validate()
+	assert.False(t, report.Valid(), "expect polygon to be invalid")
+	assert.Equal(t, 1, countDefects(report, polygon.ErrZeroLengthEdge), "expect zero length edge")
+	assert.Equal(t, 2, countDefects(report, polygon.ErrSelfIntersection), "expect two crossings")
+	var intersectionErr *polygon.SelfIntersectionError
+	assert.True(t, errors.As(report.Defects[1].Err, &intersectionErr), "expect crossing")
+	assert.Equal(t, point.Point{X: 1, Y: 1}, intersectionErr.Point, "expect first bowtie crossing")
+	assert.True(t, errors.As(report.Defects[2].Err, &intersectionErr), "expect crossing")
+	assert.Equal(t, point.Point{X: 3, Y: 1}, intersectionErr.Point, "expect second bowtie crossing")
+	assert.True(t, errors.Is(report.Err(), polygon.ErrZeroLengthEdge), "joined error should contain zero length edge")
+
+	// duplicate non-consecutive vertex
+	p = polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[0][2], c[1][1], c[2][2], c[2][0], c[1][1]}}
+	report = p.Validate()
+	var duplicateErr *polygon.DuplicateVertexError
+	assert.True(t, errors.As(report.Err(), &duplicateErr), "expect duplicate vertex")
+	assert.Equal(t, 2, duplicateErr.Index1, "unexpected first index")
+	assert.Equal(t, 5, duplicateErr.Index2, "unexpected second index")
+
+	// too few vertices
+	p = polygon.XYPolygon{Vertices: []point.Point{c[0][0], c[0][2]}}
+	report = p.Validate()
+	assert.Equal(t, 1, len(report.Defects), "expect single defect")
+	assert.True(t, errors.Is(report.Err(), polygon.ErrDimension), "expect dimension error")
+}
+
+// TestValidateWithToleranceCollinear - test that a nearly collinear vertex is reported under a tolerance, but
+// not by Validate, which only reports exactly collinear vertices
+func TestValidateWithToleranceCollinear(t *testing.T) {
+	// square with a vertex bowed out from its left edge by a hair
+	p := polygon.XYPolygon{Vertices: []point.Point{{X: 0, Y: 0}, {X: -0.0005, Y: 5}, {X: 0, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 0}}}
+	assert.Equal(t, 0, countDefects(p.Validate(), polygon.ErrCollinear), "expect no exactly collinear vertices")
+
+	report := p.ValidateWithTolerance(point.NewTolerance(0.001, 0))
+	assert.True(t, report.Valid(), "expect collinear vertex to be a warning")
+	assert.Equal(t, 1, countDefects(report, polygon.ErrCollinear), "expect nearly collinear vertex")
+	var collinearErr *polygon.CollinearVerticesError
+	assert.True(t, errors.As(report.Defects[0].Err, &collinearErr), "expect collinear vertices")
+	assert.Equal(t, 1, collinearErr.Index, "expect bowed vertex")
+
+	assert.Equal(t, 0, countDefects(p.ValidateWithTolerance(point.NewTolerance(0.0001, 0)), polygon.ErrCollinear),
+		"expect vertex bowed out further than the tolerance not to be reported")
+}
+
+// TestValidateXYRectangle - test that Validate reports every defect of an XYRectangle
+func TestValidateXYRectangle(t *testing.T) {
+	p := getTestPoints(4)
+
+	r := &polygon.XYRectangle{Vertices: [4]point.Point{p[0][0], p[0][1], p[1][1], p[1][0]}}
+	assert.True(t, r.Validate().Valid(), "expect unit square to be valid")
+
+	// two vertices off the min and max values
+	r = &polygon.XYRectangle{Vertices: [4]point.Point{p[0][0], p[1][2], p[2][1], p[3][3]}}
+	report := r.Validate()
+	assert.Equal(t, 3, len(report.Defects), "expect two vertices off min and max, and wrong counts")
+	var notOnMinMaxErr *polygon.PointNotOnMaxOrMinXYRectangleError
+	assert.True(t, errors.As(report.Defects[0].Err, &notOnMinMaxErr), "expect vertex off min and max")
+	assert.Equal(t, p[1][2], notOnMinMaxErr.Point, "unexpected vertex")
+	assert.True(t, errors.As(report.Defects[1].Err, &notOnMinMaxErr), "expect vertex off min and max")
+	assert.Equal(t, p[2][1], notOnMinMaxErr.Point, "unexpected vertex")
+	var countErr *polygon.TwoMinTwoMaxRequiredError
+	assert.True(t, errors.As(report.Defects[2].Err, &countErr), "expect wrong counts")
+
+	// duplicate vertices
+	r = &polygon.XYRectangle{Vertices: [4]point.Point{p[0][0], p[0][1], p[0][0], p[0][1]}}
+	report = r.Validate()
+	assert.Equal(t, 2, countDefects(report, polygon.ErrDuplicateVertex), "expect two duplicate pairs")
+	assert.Equal(t, 1, countDefects(report, polygon.ErrNotXYRectangle), "expect shared min and max")
+}
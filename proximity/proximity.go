@@ -0,0 +1,170 @@
+// Package proximity provides distance and closest point queries between any pair of the shapes in this
+// module: point.Point, line.LineSegment, circle.Circle, *polygon.XYRectangle and *polygon.XYPolygon.
+//
+// Polygons, rectangles and circles are treated as solid, so a shape lying wholly inside another is at
+// distance zero from it. Polygons may be concave.
+package proximity
+
+import (
+	"collision/circle"
+	"collision/internal/vec"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrUnsupportedShape - shape is not one of the types accepted by this package
+var ErrUnsupportedShape = errors.New("unsupported shape")
+
+// UnsupportedShapeError - shape passed to a query is not one of the types accepted by this package
+type UnsupportedShapeError struct {
+	Shape any // shape provided
+}
+
+func (e *UnsupportedShapeError) Error() string {
+	return fmt.Sprintf("unsupported shape of type %T", e.Shape)
+}
+
+func (e *UnsupportedShapeError) Is(target error) bool {
+	return target == ErrUnsupportedShape
+}
+
+// Distance - returns the separation between shapes a and b, zero if they touch or overlap, together with
+// the witness points on a and on b that are that distance apart
+func Distance(a, b any) (distance float32, onA, onB point.Point, err error) {
+	onA, onB, err = ClosestPoints(a, b)
+	if err != nil {
+		return 0, point.Point{}, point.Point{}, err
+	}
+	return onA.Distance(onB), onA, onB, nil
+}
+
+// ClosestPoints - returns the point on a nearest to b and the point on b nearest to a. If the shapes touch
+// or overlap, both points are the same point, lying in both shapes.
+func ClosestPoints(a, b any) (onA, onB point.Point, err error) {
+	shapeA, err := toShape(a)
+	if err != nil {
+		return point.Point{}, point.Point{}, err
+	}
+	shapeB, err := toShape(b)
+	if err != nil {
+		return point.Point{}, point.Point{}, err
+	}
+	onA, onB = closestPoints(shapeA, shapeB)
+	return onA, onB, nil
+}
+
+// shape - every supported shape is a core of vertices, swept by a radius. The core is a single point, an
+// open chain of one segment, or a closed ring which is solid if contains is set.
+type shape struct {
+	vertices []point.Point          // vertices of core
+	closed   bool                   // whether last vertex joins first
+	contains func(point.Point) bool // solid interior test of closed core, nil if hollow
+	radius   float64                // radius swept around core
+}
+
+// toShape - core and radius of a supported shape
+func toShape(s any) (shape, error) {
+	switch s := s.(type) {
+	case point.Point:
+		return shape{vertices: []point.Point{s}}, nil
+	case line.LineSegment:
+		return shape{vertices: []point.Point{s.Start, s.End}}, nil
+	case circle.Circle:
+		centre, radius := s.GetCentreAndRadius()
+		return shape{vertices: []point.Point{centre}, radius: float64(radius)}, nil
+	case *polygon.XYRectangle:
+		return shape{vertices: s.Vertices[:], closed: true, contains: s.ContainsPoint}, nil
+	case *polygon.XYPolygon:
+		if len(s.Vertices) < 3 {
+			return shape{}, &polygon.DimensionError{Got: len(s.Vertices)}
+		}
+		return shape{vertices: s.Vertices, closed: true, contains: s.ContainsPoint}, nil
+	}
+	return shape{}, &UnsupportedShapeError{Shape: s}
+}
+
+// edges - segments of core. A single point is a zero length segment.
+func (s shape) edges() []line.LineSegment {
+	order := len(s.vertices)
+	if order == 1 {
+		return []line.LineSegment{{Start: s.vertices[0], End: s.vertices[0]}}
+	}
+	count := order - 1
+	if s.closed {
+		count = order
+	}
+	edges := make([]line.LineSegment, count)
+	for i := range edges {
+		edges[i] = line.LineSegment{Start: s.vertices[i], End: s.vertices[(i+1)%order]}
+	}
+	return edges
+}
+
+// closestPoints - witness points on two shapes, found between their cores and then moved out by their radii
+func closestPoints(a, b shape) (onA, onB point.Point) {
+	coreA, coreB, overlap := closestCorePoints(a, b)
+	if overlap || coreA == coreB {
+		return coreA, coreA
+	}
+	from, to := vec.FromPoint(coreA), vec.FromPoint(coreB)
+	separation := to.Sub(from)
+	length := separation.Length()
+	if length <= a.radius+b.radius {
+		// overlapping, so take the middle of the stretch of the line between the cores lying in both shapes
+		middle := (math.Max(0, length-b.radius) + math.Min(length, a.radius)) / 2
+		common := from.Add(separation.Scale(middle / length))
+		return common.Point(), common.Point()
+	}
+	direction := separation.Scale(1 / length)
+	return from.Add(direction.Scale(a.radius)).Point(), to.Sub(direction.Scale(b.radius)).Point()
+}
+
+// closestCorePoints - nearest points of the cores of two shapes, and whether the cores touch or overlap, in
+// which case both points are the same point in both cores
+func closestCorePoints(a, b shape) (onA, onB point.Point, overlap bool) {
+	// a vertex of one core inside the other solid core
+	if a.contains != nil {
+		for _, v := range b.vertices {
+			if a.contains(v) {
+				return v, v, true
+			}
+		}
+	}
+	if b.contains != nil {
+		for _, v := range a.vertices {
+			if b.contains(v) {
+				return v, v, true
+			}
+		}
+	}
+	edgesA, edgesB := a.edges(), b.edges()
+	// crossing edges, decided exactly
+	for _, edgeA := range edgesA {
+		for _, edgeB := range edgesB {
+			if crossing, ok := edgeA.IntersectsLineSegmentRobust(edgeB); ok {
+				return crossing, crossing, true
+			}
+		}
+	}
+	// otherwise the nearest points of two segments that do not cross include an end of one of them
+	best := math.Inf(1)
+	for _, edgeA := range edgesA {
+		for _, edgeB := range edgesB {
+			for _, end := range []point.Point{edgeB.Start, edgeB.End} {
+				if nearest := edgeA.ClosestPoint(end); vec.Distance(nearest, end) < best {
+					best, onA, onB = vec.Distance(nearest, end), nearest, end
+				}
+			}
+			for _, end := range []point.Point{edgeA.Start, edgeA.End} {
+				if nearest := edgeB.ClosestPoint(end); vec.Distance(end, nearest) < best {
+					best, onA, onB = vec.Distance(end, nearest), end, nearest
+				}
+			}
+		}
+	}
+	return onA, onB, false
+}
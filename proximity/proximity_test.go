@@ -0,0 +1,79 @@
+package proximity
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDistance - test distance and witness points for each pair of shape types
+func TestDistance(t *testing.T) {
+	square, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: 0, Y: 0}, {X: 2, Y: 2}})
+	assert.Nil(t, err)
+	// concave polygon with a notch cut into its top edge down to (5, 2)
+	notched, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 3, Y: 0}, {X: 3, Y: 4}, {X: 5, Y: 2}, {X: 7, Y: 4}, {X: 7, Y: 0}})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		a, b     any
+		distance float32
+		onA, onB point.Point
+	}{
+		{"point point", point.Point{X: 0, Y: 0}, point.Point{X: 3, Y: 4}, 5, point.Point{X: 0, Y: 0}, point.Point{X: 3, Y: 4}},
+		{"point segment", point.Point{X: 4, Y: 3}, line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 10, Y: 0}}, 3, point.Point{X: 4, Y: 3}, point.Point{X: 4, Y: 0}},
+		{"point circle", point.Point{X: 0, Y: 0}, circle.NewCircle(6, 8, 5), 5, point.Point{X: 0, Y: 0}, point.Point{X: 3, Y: 4}},
+		{"point in circle", point.Point{X: 6, Y: 7}, circle.NewCircle(6, 8, 5), 0, point.Point{X: 6, Y: 7}, point.Point{X: 6, Y: 7}},
+		{"point rectangle", point.Point{X: 5, Y: 1}, square, 3, point.Point{X: 5, Y: 1}, point.Point{X: 2, Y: 1}},
+		{"point in notch", point.Point{X: 5, Y: 3}, notched, 0.70710677, point.Point{X: 5, Y: 3}, point.Point{X: 4.5, Y: 2.5}},
+		{"segment segment", line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 2, Y: 0}}, line.LineSegment{Start: point.Point{X: 4, Y: -1}, End: point.Point{X: 4, Y: 1}}, 2, point.Point{X: 2, Y: 0}, point.Point{X: 4, Y: 0}},
+		{"crossing segments", line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 2, Y: 2}}, line.LineSegment{Start: point.Point{X: 0, Y: 2}, End: point.Point{X: 2, Y: 0}}, 0, point.Point{X: 1, Y: 1}, point.Point{X: 1, Y: 1}},
+		{"segment circle", line.LineSegment{Start: point.Point{X: -5, Y: 0}, End: point.Point{X: 5, Y: 0}}, circle.NewCircle(0, 4, 1), 3, point.Point{X: 0, Y: 0}, point.Point{X: 0, Y: 3}},
+		{"segment in rectangle", line.LineSegment{Start: point.Point{X: 0.5, Y: 0.5}, End: point.Point{X: 1.5, Y: 1.5}}, square, 0, point.Point{X: 0.5, Y: 0.5}, point.Point{X: 0.5, Y: 0.5}},
+		{"circle circle", circle.NewCircle(0, 0, 1), circle.NewCircle(10, 0, 2), 7, point.Point{X: 1, Y: 0}, point.Point{X: 8, Y: 0}},
+		{"overlapping circles", circle.NewCircle(0, 0, 2), circle.NewCircle(3, 0, 2), 0, point.Point{X: 1.5, Y: 0}, point.Point{X: 1.5, Y: 0}},
+		{"circle rectangle", circle.NewCircle(1, 5, 1), square, 2, point.Point{X: 1, Y: 4}, point.Point{X: 1, Y: 2}},
+		{"circle polygon", circle.NewCircle(3, 7, 1), notched, 2, point.Point{X: 3, Y: 6}, point.Point{X: 3, Y: 4}},
+		{"rectangle polygon", square, &polygon.XYPolygon{Vertices: []point.Point{{X: 4, Y: 1}, {X: 5, Y: 2}, {X: 5, Y: 0}}}, 2, point.Point{X: 2, Y: 1}, point.Point{X: 4, Y: 1}},
+		{"polygon polygon", notched, &polygon.XYPolygon{Vertices: []point.Point{{X: 5.1, Y: 2.5}, {X: 4, Y: 6}, {X: 6, Y: 6}}}, 0.28284273, point.Point{X: 5.3, Y: 2.3}, point.Point{X: 5.1, Y: 2.5}},
+		{"rectangle in polygon", square, &polygon.XYPolygon{Vertices: []point.Point{{X: -1, Y: -1}, {X: -1, Y: 3}, {X: 3, Y: 3}, {X: 3, Y: -1}}}, 0, point.Point{X: 0, Y: 0}, point.Point{X: 0, Y: 0}},
+	}
+	for _, test := range tests {
+		distance, onA, onB, err := Distance(test.a, test.b)
+		assert.Nil(t, err, test.name)
+		assert.InDelta(t, test.distance, distance, 1e-5, test.name)
+		assert.InDelta(t, test.onA.X, onA.X, 1e-5, test.name)
+		assert.InDelta(t, test.onA.Y, onA.Y, 1e-5, test.name)
+		assert.InDelta(t, test.onB.X, onB.X, 1e-5, test.name)
+		assert.InDelta(t, test.onB.Y, onB.Y, 1e-5, test.name)
+
+		// swapping shapes swaps witness points
+		distance, onB, onA, err = Distance(test.b, test.a)
+		assert.Nil(t, err, test.name)
+		assert.InDelta(t, test.distance, distance, 1e-5, test.name)
+		assert.InDelta(t, test.onA.X, onA.X, 1e-5, test.name)
+		assert.InDelta(t, test.onB.Y, onB.Y, 1e-5, test.name)
+	}
+}
+
+// TestClosestPoints - test witness points and unsupported shapes
+func TestClosestPoints(t *testing.T) {
+	onA, onB, err := ClosestPoints(circle.NewCircle(0, 0, 1), point.Point{X: 0, Y: -3})
+	assert.Nil(t, err)
+	assert.Equal(t, point.Point{X: 0, Y: -1}, onA, "expect point on circumference")
+	assert.Equal(t, point.Point{X: 0, Y: -3}, onB)
+
+	_, _, err = ClosestPoints(point.Point{}, "square")
+	assert.True(t, errors.Is(err, ErrUnsupportedShape), "expect unsupported shape error")
+	var unsupported *UnsupportedShapeError
+	assert.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, "square", unsupported.Shape)
+
+	_, _, err = ClosestPoints(&polygon.XYPolygon{}, point.Point{})
+	assert.True(t, errors.Is(err, polygon.ErrDimension), "expect polygon without vertices to be rejected")
+}
@@ -0,0 +1,292 @@
+package replay
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/point"
+	"collision/polygon"
+	"collision/proximity"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Tags of the shapes passed to proximity queries
+const (
+	unsupportedTag uint8 = iota
+	pointTag
+	segmentTag
+	circleTag
+	rectangleTag
+	polygonTag
+)
+
+// encoder - compact little endian encoding of call arguments and results
+type encoder []byte
+
+func (e encoder) u8(v uint8) encoder {
+	return append(e, v)
+}
+
+func (e encoder) uvarint(v uint64) encoder {
+	return binary.AppendUvarint(e, v)
+}
+
+func (e encoder) bool(v bool) encoder {
+	if v {
+		return e.u8(1)
+	}
+	return e.u8(0)
+}
+
+func (e encoder) f32(v float32) encoder {
+	return binary.LittleEndian.AppendUint32(e, math.Float32bits(v))
+}
+
+func (e encoder) f64(v float64) encoder {
+	return binary.LittleEndian.AppendUint64(e, math.Float64bits(v))
+}
+
+func (e encoder) u64(v uint64) encoder {
+	return binary.LittleEndian.AppendUint64(e, v)
+}
+
+func (e encoder) bytes(b []byte) encoder {
+	return append(e.uvarint(uint64(len(b))), b...)
+}
+
+func (e encoder) point(p point.Point) encoder {
+	return p.AppendBinary(e)
+}
+
+func (e encoder) points(ps []point.Point) encoder {
+	e = e.uvarint(uint64(len(ps)))
+	for _, p := range ps {
+		e = e.point(p)
+	}
+	return e
+}
+
+func (e encoder) segment(ls line.LineSegment) encoder {
+	return e.point(ls.Start).point(ls.End)
+}
+
+func (e encoder) segments(segments []line.LineSegment) encoder {
+	e = e.uvarint(uint64(len(segments)))
+	for _, ls := range segments {
+		e = e.segment(ls)
+	}
+	return e
+}
+
+func (e encoder) circle(c circle.Circle) encoder {
+	return c.AppendBinary(e)
+}
+
+// rectangle - encode vertices and edges of a rectangle as they are, whether or not they agree
+func (e encoder) rectangle(r *polygon.XYRectangle) encoder {
+	return e.points(r.Vertices[:]).segments(r.Edges)
+}
+
+// polygon - encode vertices and edges of a polygon as they are, whether or not they agree
+func (e encoder) polygon(p *polygon.XYPolygon) encoder {
+	return e.points(p.Vertices).segments(p.Edges)
+}
+
+// shape - encode any shape accepted by the proximity package, tagged with its type. Other values are encoded as
+// unsupported, which replays as a value of no supported type.
+func (e encoder) shape(s any) encoder {
+	switch s := s.(type) {
+	case point.Point:
+		return e.u8(pointTag).point(s)
+	case line.LineSegment:
+		return e.u8(segmentTag).segment(s)
+	case circle.Circle:
+		return e.u8(circleTag).circle(s)
+	case *polygon.XYRectangle:
+		return e.u8(rectangleTag).rectangle(s)
+	case *polygon.XYPolygon:
+		return e.u8(polygonTag).polygon(s)
+	}
+	return e.u8(unsupportedTag)
+}
+
+// Kinds of error returned by a query
+const (
+	noError uint8 = iota
+	messageError
+	unsupportedShapeError
+)
+
+// err - encode whether an error occurred, and its message if it did. The message of an unsupported shape error
+// names the type of the shape, which is lost in the log, so only its kind is kept.
+func (e encoder) err(err error) encoder {
+	switch {
+	case err == nil:
+		return e.u8(noError)
+	case errors.Is(err, proximity.ErrUnsupportedShape):
+		return e.u8(unsupportedShapeError)
+	}
+	return e.u8(messageError).bytes([]byte(err.Error()))
+}
+
+// decoder - reader of data made by encoder, which records the first failure and reads zeros after it
+type decoder struct {
+	data []byte
+	ok   bool
+}
+
+// newDecoder - decoder of data
+func newDecoder(data []byte) *decoder {
+	return &decoder{data: data, ok: true}
+}
+
+// take - next n bytes, or nil if there are fewer left
+func (d *decoder) take(n int) []byte {
+	if !d.ok || n < 0 || len(d.data) < n {
+		d.ok, d.data = false, nil
+		return nil
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+// done - boolean indicating whether everything was read without failure
+func (d *decoder) done() bool {
+	return d.ok && len(d.data) == 0
+}
+
+func (d *decoder) u8() uint8 {
+	if b := d.take(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uvarint() uint64 {
+	if !d.ok {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.ok, d.data = false, nil
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) bool() bool {
+	return d.u8() != 0
+}
+
+func (d *decoder) f32() float32 {
+	if b := d.take(4); b != nil {
+		return math.Float32frombits(binary.LittleEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *decoder) f64() float64 {
+	if b := d.take(8); b != nil {
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *decoder) u64() uint64 {
+	if b := d.take(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		d.ok, d.data = false, nil
+		return nil
+	}
+	return d.take(int(n))
+}
+
+func (d *decoder) point() point.Point {
+	var p point.Point
+	if b := d.take(point.BinarySize); b != nil {
+		_ = p.UnmarshalBinary(b)
+	}
+	return p
+}
+
+func (d *decoder) points() []point.Point {
+	n := d.uvarint()
+	if n > uint64(len(d.data)/point.BinarySize) {
+		d.ok, d.data = false, nil
+		return nil
+	}
+	ps := make([]point.Point, n)
+	for i := range ps {
+		ps[i] = d.point()
+	}
+	return ps
+}
+
+func (d *decoder) segment() line.LineSegment {
+	return line.LineSegment{Start: d.point(), End: d.point()}
+}
+
+func (d *decoder) segments() []line.LineSegment {
+	n := d.uvarint()
+	if n > uint64(len(d.data)/(2*point.BinarySize)) {
+		d.ok, d.data = false, nil
+		return nil
+	}
+	segments := make([]line.LineSegment, n)
+	for i := range segments {
+		segments[i] = d.segment()
+	}
+	return segments
+}
+
+func (d *decoder) circle() circle.Circle {
+	var c circle.Circle
+	if b := d.take(circle.BinarySize); b != nil && c.UnmarshalBinary(b) != nil {
+		d.ok, d.data = false, nil
+	}
+	return c
+}
+
+func (d *decoder) rectangle() *polygon.XYRectangle {
+	r := &polygon.XYRectangle{}
+	if vertices := d.points(); len(vertices) == len(r.Vertices) {
+		copy(r.Vertices[:], vertices)
+	} else {
+		d.ok, d.data = false, nil
+	}
+	r.Edges = d.segments()
+	return r
+}
+
+func (d *decoder) polygon() *polygon.XYPolygon {
+	return &polygon.XYPolygon{Vertices: d.points(), Edges: d.segments()}
+}
+
+// unsupported - stand in for a shape of a type the proximity package does not accept
+type unsupported struct{}
+
+func (d *decoder) shape() any {
+	switch d.u8() {
+	case pointTag:
+		return d.point()
+	case segmentTag:
+		return d.segment()
+	case circleTag:
+		return d.circle()
+	case rectangleTag:
+		return d.rectangle()
+	case polygonTag:
+		return d.polygon()
+	}
+	return unsupported{}
+}
@@ -0,0 +1,225 @@
+package replay
+
+import (
+	"bytes"
+	"collision/physics"
+	"collision/proximity"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidLog - returned when data to replay is not a log written by a Recorder
+var ErrInvalidLog = errors.New("invalid replay log")
+
+// Mismatch - call whose result on replay differs from the result recorded
+type Mismatch struct {
+	Call     int    // index of the call in the log, counting from zero
+	Name     string // name of the call
+	Recorded string // result recorded
+	Replayed string // result on replay
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("call %d %s: recorded %s, replayed %s", m.Call, m.Name, m.Recorded, m.Replayed)
+}
+
+// operation - how to replay a call logged by a Recorder, and describe its result
+type operation struct {
+	name     string
+	replay   func(p *player, in *decoder) func() encoder // decode the inputs of a call, returning how to make it
+	describe func(out *decoder) string
+}
+
+var operations = map[uint8]operation{
+	intersectsLineSegmentOp: {
+		name: "IntersectsLineSegment",
+		replay: func(_ *player, in *decoder) func() encoder {
+			a, b := in.segment(), in.segment()
+			return func() encoder { return pointResult(a.IntersectsLineSegment(b)) }
+		},
+		describe: describePoint,
+	},
+	intersectsLineSegmentRobustOp: {
+		name: "IntersectsLineSegmentRobust",
+		replay: func(_ *player, in *decoder) func() encoder {
+			a, b := in.segment(), in.segment()
+			return func() encoder { return pointResult(a.IntersectsLineSegmentRobust(b)) }
+		},
+		describe: describePoint,
+	},
+	circlesIntersectOp: {
+		name: "CirclesIntersect",
+		replay: func(_ *player, in *decoder) func() encoder {
+			c, d := in.circle(), in.circle()
+			return func() encoder { return encoder{}.bool(c.CirclesIntersect(d)) }
+		},
+		describe: describeBool,
+	},
+	circleIntersectsLineSegmentOp: {
+		name: "CircleIntersectsLineSegment",
+		replay: func(_ *player, in *decoder) func() encoder {
+			c, ls := in.circle(), in.segment()
+			return func() encoder { return encoder{}.bool(c.InstersectsLineSegment(ls)) }
+		},
+		describe: describeBool,
+	},
+	circleContainsPointOp: {
+		name: "CircleContainsPoint",
+		replay: func(_ *player, in *decoder) func() encoder {
+			c, pt := in.circle(), in.point()
+			return func() encoder { return encoder{}.bool(c.ContainsPoint(pt)) }
+		},
+		describe: describeBool,
+	},
+	rectangleContainsPointOp: {
+		name: "RectangleContainsPoint",
+		replay: func(_ *player, in *decoder) func() encoder {
+			r, pt := in.rectangle(), in.point()
+			return func() encoder { return encoder{}.bool(r.ContainsPoint(pt)) }
+		},
+		describe: describeBool,
+	},
+	rectangleIntersectsLineSegmentOp: {
+		name: "RectangleIntersectsLineSegment",
+		replay: func(_ *player, in *decoder) func() encoder {
+			r, ls := in.rectangle(), in.segment()
+			return func() encoder { return pointsResult(r.IntersectsLineSegment(ls)) }
+		},
+		describe: func(out *decoder) string {
+			return fmt.Sprintf("%v %t", out.points(), out.bool())
+		},
+	},
+	distanceOp: {
+		name: "Distance",
+		replay: func(_ *player, in *decoder) func() encoder {
+			a, b := in.shape(), in.shape()
+			return func() encoder { return distanceResult(proximity.Distance(a, b)) }
+		},
+		describe: func(out *decoder) string {
+			switch out.u8() {
+			case unsupportedShapeError:
+				return "error: " + proximity.ErrUnsupportedShape.Error()
+			case messageError:
+				return "error: " + string(out.bytes())
+			}
+			return fmt.Sprintf("%v %v %v", out.f32(), out.point(), out.point())
+		},
+	},
+	stepOp: {
+		name: "Step",
+		replay: func(p *player, in *decoder) func() encoder {
+			w, elapsed := p.world(in.uvarint()), in.f64()
+			if w == nil {
+				return nil
+			}
+			return func() encoder {
+				steps := w.Step(elapsed)
+				return stepResult(steps, digest(w.Snapshot()))
+			}
+		},
+		describe: func(out *decoder) string {
+			return fmt.Sprintf("%d steps to state %016x", out.uvarint(), out.u64())
+		},
+	},
+}
+
+func describePoint(out *decoder) string {
+	return fmt.Sprintf("%v %t", out.point(), out.bool())
+}
+
+func describeBool(out *decoder) string {
+	return fmt.Sprintf("%t", out.bool())
+}
+
+// player - worlds of a log being replayed, by their number in the log
+type player struct {
+	newWorld func() *physics.World
+	worlds   map[uint64]*physics.World
+}
+
+// world - the world with a number in the log, or nil if no state has been logged for it
+func (p *player) world(number uint64) *physics.World {
+	return p.worlds[number]
+}
+
+// restore - set the state of a world in the log, making the world if this is its first state
+func (p *player) restore(in *decoder) error {
+	number, snapshot := in.uvarint(), in.bytes()
+	if !in.done() {
+		return ErrInvalidLog
+	}
+	w, ok := p.worlds[number]
+	if !ok {
+		w = p.newWorld()
+	}
+	if err := w.Restore(snapshot); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidLog, err)
+	}
+	p.worlds[number] = w
+	return nil
+}
+
+// Replay - re-execute every call in a log written by a Recorder, and compare each result with the one recorded.
+// Worlds in the log are made by newWorld, or by physics.NewWorld without gravity if newWorld is nil, and then
+// restored from their logged state. Callbacks and collision predicates are not logged, so newWorld should set
+// any that change how the recorded worlds stepped. Returns the number of calls replayed and the calls whose
+// results differ. Returns ErrInvalidLog, with the calls replayed so far, if the log is cut short or corrupt.
+func Replay(r io.Reader, newWorld func() *physics.World) (int, []Mismatch, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !bytes.HasPrefix(data, logHeader) {
+		return 0, nil, ErrInvalidLog
+	}
+	if newWorld == nil {
+		newWorld = func() *physics.World { return physics.NewWorld(physics.Vec2{}) }
+	}
+	p := &player{newWorld: newWorld, worlds: map[uint64]*physics.World{}}
+
+	log := newDecoder(data[len(logHeader):])
+	calls := 0
+	var mismatches []Mismatch
+	for !log.done() {
+		op, in, recorded := log.u8(), newDecoder(log.bytes()), log.bytes()
+		if !log.ok {
+			return calls, mismatches, ErrInvalidLog
+		}
+		if op == worldStateOp {
+			if err := p.restore(in); err != nil {
+				return calls, mismatches, err
+			}
+			continue
+		}
+		o, ok := operations[op]
+		if !ok {
+			return calls, mismatches, ErrInvalidLog
+		}
+		call := o.replay(p, in)
+		if !in.done() || call == nil {
+			return calls, mismatches, ErrInvalidLog
+		}
+		replayed := call()
+		if !bytes.Equal(recorded, replayed) {
+			mismatches = append(mismatches, Mismatch{
+				Call:     calls,
+				Name:     o.name,
+				Recorded: describe(o, recorded),
+				Replayed: describe(o, replayed),
+			})
+		}
+		calls++
+	}
+	return calls, mismatches, nil
+}
+
+// describe - readable form of an encoded result, or its bytes if it cannot be decoded
+func describe(o operation, out []byte) string {
+	d := newDecoder(out)
+	s := o.describe(d)
+	if !d.done() {
+		return fmt.Sprintf("% x", out)
+	}
+	return s
+}
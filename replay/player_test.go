@@ -0,0 +1,130 @@
+package replay
+
+import (
+	"bytes"
+	"collision/circle"
+	"collision/line"
+	"collision/physics"
+	"collision/point"
+	"collision/polygon"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordAll - log one of every kind of call, returning the log
+func recordAll(t *testing.T) []byte {
+	t.Helper()
+	var log bytes.Buffer
+	r := NewRecorder(&log)
+	a := line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 2, Y: 2}}
+	b := line.LineSegment{Start: point.Point{X: 0, Y: 2}, End: point.Point{X: 2, Y: 0}}
+	c := circle.NewCircle(0, 0, 1)
+	rect, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: -1, Y: -1}, {X: 1, Y: 1}})
+	assert.Nil(t, err)
+	triangle, err := polygon.NewValidatedXYPolygon([]point.Point{{X: 3, Y: 0}, {X: 5, Y: 0}, {X: 4, Y: 2}})
+	assert.Nil(t, err)
+
+	r.IntersectsLineSegment(a, b)
+	r.IntersectsLineSegmentRobust(a, b)
+	r.CirclesIntersect(c, circle.NewCircle(3, 0, 1))
+	r.CircleIntersectsLineSegment(c, a)
+	r.CircleContainsPoint(c, point.Point{X: 0.5})
+	r.RectangleContainsPoint(rect, point.Point{X: 2})
+	r.RectangleIntersectsLineSegment(rect, b)
+	r.Distance(c, triangle)
+	r.Distance(a, rect)
+	r.Distance(&polygon.XYPolygon{}, c)
+	r.Distance(3, c)
+
+	w, ball := newFallingWorld(t)
+	r.Step(w, 0.5)
+	ball.ApplyImpulse(physics.Vec2{X: 1}, ball.Position)
+	r.Step(w, 0.5)
+	r.Step(w, 0.01)
+	assert.Nil(t, r.Err())
+	assert.Equal(t, 14, r.Calls())
+	return log.Bytes()
+}
+
+// TestReplay - test that every kind of call replays with the result it was recorded with
+func TestReplay(t *testing.T) {
+	calls, mismatches, err := Replay(bytes.NewReader(recordAll(t)), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 14, calls)
+	assert.Empty(t, mismatches)
+}
+
+// TestReplayMismatch - test that results differing from those recorded are reported, and the replay carries on
+func TestReplayMismatch(t *testing.T) {
+	var log bytes.Buffer
+	r := NewRecorder(&log)
+	r.CirclesIntersect(circle.NewCircle(0, 0, 1), circle.NewCircle(1, 0, 1))
+	tampered := bytes.Clone(log.Bytes())
+	tampered[len(tampered)-1] = 0
+	r.CircleContainsPoint(circle.NewCircle(0, 0, 1), point.Point{})
+	tampered = append(tampered, log.Bytes()[len(tampered):]...)
+
+	calls, mismatches, err := Replay(bytes.NewReader(tampered), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []Mismatch{{Call: 0, Name: "CirclesIntersect", Recorded: "false", Replayed: "true"}}, mismatches)
+	assert.Equal(t, "call 0 CirclesIntersect: recorded false, replayed true", mismatches[0].String())
+
+	// a world replayed without the collision predicate it was recorded with steps differently
+	log.Reset()
+	r = NewRecorder(&log)
+	w, _ := newFallingWorld(t)
+	w.ShouldCollide = func(a, b *physics.Body) bool { return false }
+	w.MaxSteps = 60
+	r.Step(w, 1)
+	calls, mismatches, err = Replay(bytes.NewReader(log.Bytes()), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	if assert.Len(t, mismatches, 1) {
+		assert.Equal(t, "Step", mismatches[0].Name)
+		assert.Regexp(t, `^\d+ steps to state [0-9a-f]{16}$`, mismatches[0].Recorded)
+	}
+
+	calls, mismatches, err = Replay(bytes.NewReader(log.Bytes()), func() *physics.World {
+		w := physics.NewWorld(physics.Vec2{})
+		w.ShouldCollide = func(a, b *physics.Body) bool { return false }
+		return w
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, mismatches)
+}
+
+// TestReplayInvalid - test that data other than a whole log is rejected
+func TestReplayInvalid(t *testing.T) {
+	log := recordAll(t)
+	for name, data := range map[string][]byte{
+		"empty":        nil,
+		"header":       []byte("CREC\x02"),
+		"truncated":    log[:len(log)-1],
+		"unknown op":   append(bytes.Clone(logHeader), 0xff, 0, 0),
+		"short input":  append(bytes.Clone(logHeader), circlesIntersectOp, 1, 0, 1, 1),
+		"no world":     append(bytes.Clone(logHeader), encoder{}.u8(stepOp).bytes(encoder{}.uvarint(0).f64(1)).bytes(nil)...),
+		"bad snapshot": append(bytes.Clone(logHeader), worldStateOp, 3, 0, 1, 'P', 0),
+	} {
+		_, _, err := Replay(bytes.NewReader(data), nil)
+		assert.ErrorIs(t, err, ErrInvalidLog, name)
+	}
+
+	// calls before the log is cut short are still replayed
+	calls, _, err := Replay(bytes.NewReader(log[:len(log)-1]), nil)
+	assert.ErrorIs(t, err, ErrInvalidLog)
+	assert.Equal(t, 13, calls)
+}
+
+// TestReplayUnsupportedCall - test that a log holding a call outside the fixed set a Recorder logs is rejected
+func TestReplayUnsupportedCall(t *testing.T) {
+	for op := 0; op <= 0xff; op++ {
+		if _, ok := operations[uint8(op)]; ok || uint8(op) == worldStateOp {
+			continue
+		}
+		_, _, err := Replay(bytes.NewReader(append(bytes.Clone(logHeader), uint8(op), 0, 0)), nil)
+		assert.ErrorIs(t, err, ErrInvalidLog, "op %d", op)
+	}
+}
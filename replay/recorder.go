@@ -0,0 +1,223 @@
+// Package replay records calls into the module's query functions and physics worlds, with their inputs and
+// results, to a compact binary log, and replays a log to check that every call still gives the result it gave
+// when recorded.
+//
+// Only the fixed set of calls with a method on Recorder is logged: segment intersection, exact and robust,
+// circle intersection and containment, rectangle containment and segment intersection, proximity.Distance and
+// physics.World.Step. Other functions of the module, including the tolerance variants of these queries, are not
+// recorded, and a log holding any other call is rejected by Replay as invalid.
+//
+// Recording is optional: a nil *Recorder passes every call straight through. Worlds are logged as snapshots
+// when first stepped, and again whenever they have been changed between steps, so a replay starts each step from
+// exactly the state it was recorded from. The result of a step is logged as the number of fixed steps taken and
+// a digest of the world's snapshot after them. Inputs such as forces and impulses are not logged separately, so
+// a world changed before every step, for example by applying a force each frame, logs a full snapshot per step
+// and its log grows with the size of the world rather than with the calls made.
+package replay
+
+import (
+	"collision/circle"
+	"collision/line"
+	"collision/physics"
+	"collision/point"
+	"collision/polygon"
+	"collision/proximity"
+	"hash/fnv"
+	"io"
+	"sync"
+)
+
+// logHeader - bytes opening every log, the last of them its format version
+var logHeader = []byte{'C', 'R', 'E', 'C', 1}
+
+// Operations in a log
+const (
+	intersectsLineSegmentOp uint8 = iota + 1
+	intersectsLineSegmentRobustOp
+	circlesIntersectOp
+	circleIntersectsLineSegmentOp
+	circleContainsPointOp
+	rectangleContainsPointOp
+	rectangleIntersectsLineSegmentOp
+	distanceOp
+	worldStateOp
+	stepOp
+)
+
+// Recorder - writer of calls and their results to a log. Safe for concurrent use, though concurrent calls are
+// logged in the order they finish.
+type Recorder struct {
+	mu     sync.Mutex
+	w      io.Writer
+	err    error                             // first error writing the log
+	calls  int                               // calls logged
+	worlds map[*physics.World]*recordedWorld // worlds stepped, which are kept for the life of the recorder
+}
+
+// recordedWorld - number of a world in the log, and the digest of its state when last logged
+type recordedWorld struct {
+	number uint64
+	digest uint64
+}
+
+// NewRecorder - returns a pointer to a recorder writing a log to w, which is written one call at a time
+func NewRecorder(w io.Writer) *Recorder {
+	r := &Recorder{w: w, worlds: map[*physics.World]*recordedWorld{}}
+	_, r.err = w.Write(logHeader)
+	return r
+}
+
+// Err - returns the first error writing the log, after which nothing more is written
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Calls - returns the number of calls logged
+func (r *Recorder) Calls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+// record - log a call with its encoded inputs and result
+func (r *Recorder) record(op uint8, in, out encoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.write(op, in, out)
+	r.calls++
+}
+
+// write - write one record of the log in a single write. Must be called with the lock held.
+func (r *Recorder) write(op uint8, in, out encoder) {
+	if r.err != nil {
+		return
+	}
+	_, r.err = r.w.Write(encoder{}.u8(op).bytes(in).bytes(out))
+}
+
+// IntersectsLineSegment - returns a.IntersectsLineSegment(b), logging the call
+func (r *Recorder) IntersectsLineSegment(a, b line.LineSegment) (point.Point, bool) {
+	p, ok := a.IntersectsLineSegment(b)
+	if r != nil {
+		r.record(intersectsLineSegmentOp, encoder{}.segment(a).segment(b), pointResult(p, ok))
+	}
+	return p, ok
+}
+
+// IntersectsLineSegmentRobust - returns a.IntersectsLineSegmentRobust(b), logging the call
+func (r *Recorder) IntersectsLineSegmentRobust(a, b line.LineSegment) (point.Point, bool) {
+	p, ok := a.IntersectsLineSegmentRobust(b)
+	if r != nil {
+		r.record(intersectsLineSegmentRobustOp, encoder{}.segment(a).segment(b), pointResult(p, ok))
+	}
+	return p, ok
+}
+
+// CirclesIntersect - returns c.CirclesIntersect(d), logging the call
+func (r *Recorder) CirclesIntersect(c, d circle.Circle) bool {
+	ok := c.CirclesIntersect(d)
+	if r != nil {
+		r.record(circlesIntersectOp, encoder{}.circle(c).circle(d), encoder{}.bool(ok))
+	}
+	return ok
+}
+
+// CircleIntersectsLineSegment - returns c.InstersectsLineSegment(ls), logging the call
+func (r *Recorder) CircleIntersectsLineSegment(c circle.Circle, ls line.LineSegment) bool {
+	ok := c.InstersectsLineSegment(ls)
+	if r != nil {
+		r.record(circleIntersectsLineSegmentOp, encoder{}.circle(c).segment(ls), encoder{}.bool(ok))
+	}
+	return ok
+}
+
+// CircleContainsPoint - returns c.ContainsPoint(p), logging the call
+func (r *Recorder) CircleContainsPoint(c circle.Circle, p point.Point) bool {
+	ok := c.ContainsPoint(p)
+	if r != nil {
+		r.record(circleContainsPointOp, encoder{}.circle(c).point(p), encoder{}.bool(ok))
+	}
+	return ok
+}
+
+// RectangleContainsPoint - returns rect.ContainsPoint(p), logging the call
+func (r *Recorder) RectangleContainsPoint(rect *polygon.XYRectangle, p point.Point) bool {
+	ok := rect.ContainsPoint(p)
+	if r != nil {
+		r.record(rectangleContainsPointOp, encoder{}.rectangle(rect).point(p), encoder{}.bool(ok))
+	}
+	return ok
+}
+
+// RectangleIntersectsLineSegment - returns rect.IntersectsLineSegment(ls), logging the call
+func (r *Recorder) RectangleIntersectsLineSegment(rect *polygon.XYRectangle, ls line.LineSegment) ([]point.Point, bool) {
+	ps, ok := rect.IntersectsLineSegment(ls)
+	if r != nil {
+		r.record(rectangleIntersectsLineSegmentOp, encoder{}.rectangle(rect).segment(ls), pointsResult(ps, ok))
+	}
+	return ps, ok
+}
+
+// Distance - returns proximity.Distance(a, b), logging the call. Shapes of types the proximity package does not
+// accept are logged without their value.
+func (r *Recorder) Distance(a, b any) (float32, point.Point, point.Point, error) {
+	distance, onA, onB, err := proximity.Distance(a, b)
+	if r != nil {
+		r.record(distanceOp, encoder{}.shape(a).shape(b), distanceResult(distance, onA, onB, err))
+	}
+	return distance, onA, onB, err
+}
+
+// Step - returns w.Step(elapsed), logging the call. The state of the world is logged first if it is the first
+// time the world is stepped, or if it has changed since it was last stepped.
+func (r *Recorder) Step(w *physics.World, elapsed float64) int {
+	if r == nil {
+		return w.Step(elapsed)
+	}
+	r.mu.Lock()
+	rw, ok := r.worlds[w]
+	if !ok {
+		rw = &recordedWorld{number: uint64(len(r.worlds))}
+		r.worlds[w] = rw
+	}
+	if snapshot := w.Snapshot(); !ok || digest(snapshot) != rw.digest {
+		r.write(worldStateOp, encoder{}.uvarint(rw.number).bytes(snapshot), nil)
+	}
+	r.mu.Unlock()
+
+	// the lock is not held while stepping, so callbacks may make logged calls of their own
+	steps := w.Step(elapsed)
+	after := digest(w.Snapshot())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rw.digest = after
+	r.write(stepOp, encoder{}.uvarint(rw.number).f64(elapsed), stepResult(steps, after))
+	r.calls++
+	return steps
+}
+
+// digest - FNV-1a hash of a world snapshot
+func digest(snapshot []byte) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(snapshot)
+	return h.Sum64()
+}
+
+func pointResult(p point.Point, ok bool) encoder {
+	return encoder{}.point(p).bool(ok)
+}
+
+func pointsResult(ps []point.Point, ok bool) encoder {
+	return encoder{}.points(ps).bool(ok)
+}
+
+func distanceResult(distance float32, onA, onB point.Point, err error) encoder {
+	return encoder{}.err(err).f32(distance).point(onA).point(onB)
+}
+
+func stepResult(steps int, digest uint64) encoder {
+	return encoder{}.uvarint(uint64(steps)).u64(digest)
+}
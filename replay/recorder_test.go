@@ -0,0 +1,112 @@
+package replay
+
+import (
+	"bytes"
+	"collision/circle"
+	"collision/line"
+	"collision/physics"
+	"collision/point"
+	"collision/polygon"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFallingWorld - world with gravity holding a ground box and a ball dropped onto it
+func newFallingWorld(t *testing.T) (*physics.World, *physics.Body) {
+	t.Helper()
+	w := physics.NewWorld(physics.Vec2{Y: -10})
+	r, err := polygon.NewValidatedXYRectangleFromOppositeVertices([]point.Point{{X: -5, Y: -1}, {X: 5, Y: 0}})
+	assert.Nil(t, err)
+	assert.Nil(t, w.AddBody(physics.NewBody(physics.StaticBody, physics.NewRectangleShape(r), 1)))
+	ball := physics.NewBody(physics.DynamicBody, physics.NewCircleShape(circle.NewCircle(0, 2, 0.5)), 1)
+	assert.Nil(t, w.AddBody(ball))
+	return w, ball
+}
+
+// logOps - operations of the records in a log, in order
+func logOps(t *testing.T, log []byte) []uint8 {
+	t.Helper()
+	assert.True(t, bytes.HasPrefix(log, logHeader))
+	d := newDecoder(log[len(logHeader):])
+	var ops []uint8
+	for !d.done() {
+		ops = append(ops, d.u8())
+		d.bytes()
+		d.bytes()
+		assert.True(t, d.ok)
+	}
+	return ops
+}
+
+// TestNilRecorder - test that a nil recorder passes calls straight through
+func TestNilRecorder(t *testing.T) {
+	var r *Recorder
+	a := line.LineSegment{Start: point.Point{X: 0, Y: 0}, End: point.Point{X: 2, Y: 2}}
+	b := line.LineSegment{Start: point.Point{X: 0, Y: 2}, End: point.Point{X: 2, Y: 0}}
+	p, ok := r.IntersectsLineSegment(a, b)
+	assert.True(t, ok)
+	assert.Equal(t, point.Point{X: 1, Y: 1}, p)
+	assert.True(t, r.CirclesIntersect(circle.NewCircle(0, 0, 1), circle.NewCircle(1.5, 0, 1)))
+
+	w, ball := newFallingWorld(t)
+	assert.Equal(t, 2, r.Step(w, 2*w.TimeStep))
+	assert.Less(t, ball.Position.Y, 2.0)
+}
+
+// TestRecordWorldState - test that a world's state is logged when it is first stepped and whenever it has been
+// changed between steps, but not when it is only stepped
+func TestRecordWorldState(t *testing.T) {
+	var log bytes.Buffer
+	r := NewRecorder(&log)
+	w, ball := newFallingWorld(t)
+	other, _ := newFallingWorld(t)
+
+	r.Step(w, w.TimeStep)
+	r.Step(w, w.TimeStep)
+	r.Step(other, w.TimeStep)
+	ball.Velocity = physics.Vec2{X: 1}
+	r.Step(w, w.TimeStep)
+	r.CircleContainsPoint(circle.NewCircle(0, 0, 1), point.Point{})
+
+	assert.Nil(t, r.Err())
+	assert.Equal(t, 5, r.Calls())
+	assert.Equal(t, []uint8{
+		worldStateOp, stepOp, stepOp,
+		worldStateOp, stepOp,
+		worldStateOp, stepOp,
+		circleContainsPointOp,
+	}, logOps(t, log.Bytes()))
+}
+
+// failingWriter - writer accepting a number of writes, then failing
+type failingWriter struct {
+	writes int
+}
+
+var errWrite = errors.New("write failed")
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if f.writes == 0 {
+		return 0, errWrite
+	}
+	f.writes--
+	return len(p), nil
+}
+
+// TestRecorderWriteError - test that the first error writing the log is kept, and calls still give their results
+func TestRecorderWriteError(t *testing.T) {
+	f := &failingWriter{writes: 2}
+	r := NewRecorder(f)
+	c := circle.NewCircle(0, 0, 1)
+	assert.True(t, r.CircleContainsPoint(c, point.Point{}))
+	assert.Nil(t, r.Err())
+	assert.False(t, r.CircleContainsPoint(c, point.Point{X: 2}))
+	assert.ErrorIs(t, r.Err(), errWrite)
+
+	f.writes = 1
+	assert.True(t, r.CircleContainsPoint(c, point.Point{}))
+	assert.Equal(t, 1, f.writes, "expect nothing written after an error")
+	assert.ErrorIs(t, NewRecorder(&failingWriter{}).Err(), errWrite)
+}
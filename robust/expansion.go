@@ -0,0 +1,127 @@
+package robust
+
+import "math"
+
+// expansion - a floating point expansion as described by Shewchuk: a sum of non-overlapping float64
+// components, ordered by increasing magnitude. The value represented is the exact sum of the components.
+type expansion []float64
+
+// twoSum - returns x = fl(a + b) and the round-off error y, such that a + b = x + y exactly
+func twoSum(a, b float64) (x, y float64) {
+	x = a + b
+	bVirtual := x - a
+	aVirtual := x - bVirtual
+	bRoundOff := b - bVirtual
+	aRoundOff := a - aVirtual
+	y = aRoundOff + bRoundOff
+	return
+}
+
+// twoDiff - returns x = fl(a - b) and the round-off error y, such that a - b = x + y exactly
+func twoDiff(a, b float64) (x, y float64) {
+	return twoSum(a, -b)
+}
+
+// twoProduct - returns x = fl(a * b) and the round-off error y, such that a * b = x + y exactly
+func twoProduct(a, b float64) (x, y float64) {
+	x = a * b
+	y = math.FMA(a, b, -x)
+	return
+}
+
+// exactDiff - returns a - b as an exact expansion
+func exactDiff(a, b float64) expansion {
+	x, y := twoDiff(a, b)
+	return compress(expansion{y, x})
+}
+
+// compress - remove zero components from an expansion
+func compress(e expansion) expansion {
+	out := e[:0]
+	for _, component := range e {
+		if component != 0 {
+			out = append(out, component)
+		}
+	}
+	return out
+}
+
+// grow - add a single float64 to an expansion, returning a new expansion with zero components eliminated
+func grow(e expansion, b float64) expansion {
+	out := make(expansion, 0, len(e)+1)
+	q := b
+	for _, component := range e {
+		var h float64
+		q, h = twoSum(q, component)
+		if h != 0 {
+			out = append(out, h)
+		}
+	}
+	if q != 0 || len(out) == 0 {
+		out = append(out, q)
+	}
+	return out
+}
+
+// sum - exact sum of two expansions
+func sum(e, f expansion) expansion {
+	out := append(expansion{}, e...)
+	for _, component := range f {
+		out = grow(out, component)
+	}
+	return out
+}
+
+// negate - exact negation of an expansion
+func negate(e expansion) expansion {
+	out := make(expansion, len(e))
+	for i, component := range e {
+		out[i] = -component
+	}
+	return out
+}
+
+// scale - exact product of an expansion and a single float64
+func scale(e expansion, b float64) expansion {
+	out := expansion{}
+	for _, component := range e {
+		product, roundOff := twoProduct(component, b)
+		out = grow(grow(out, roundOff), product)
+	}
+	if len(out) == 0 {
+		out = append(out, 0)
+	}
+	return out
+}
+
+// product - exact product of two expansions
+func product(e, f expansion) expansion {
+	out := expansion{0}
+	for _, component := range f {
+		out = sum(out, scale(e, component))
+	}
+	return out
+}
+
+// estimate - approximate value of an expansion
+func estimate(e expansion) float64 {
+	total := 0.0
+	for _, component := range e {
+		total += component
+	}
+	return total
+}
+
+// sign - exact sign of an expansion. As components are non-overlapping and ordered by increasing
+// magnitude, the sign of the largest non-zero component is the sign of the whole expansion.
+func sign(e expansion) float64 {
+	for i := len(e) - 1; i >= 0; i-- {
+		if e[i] > 0 {
+			return 1
+		}
+		if e[i] < 0 {
+			return -1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,168 @@
+// Package robust provides adaptive precision geometric predicates in the style of Shewchuk's
+// "Adaptive Precision Floating-Point Arithmetic and Fast Robust Geometric Predicates".
+// Each predicate is first evaluated in float64 with a forward error bound. Only if the result is too
+// close to zero to trust is it re-evaluated with exact expansion arithmetic, so the sign returned is
+// always correct for the float32 coordinates provided.
+package robust
+
+import (
+	"collision/point"
+	"math"
+)
+
+// epsilon - half of a float64 unit in the last place, 2^-53
+const epsilon = 1.0 / (1 << 53)
+
+// error bounds on the float64 evaluation of each predicate, from Shewchuk
+const (
+	orientErrorBound   = (3 + 16*epsilon) * epsilon
+	inCircleErrorBound = (10 + 96*epsilon) * epsilon
+)
+
+// Orientation - side of a directed line on which a point lies
+type Orientation int
+
+const (
+	Clockwise        Orientation = -1 // point lies to the right of the directed line
+	Collinear        Orientation = 0  // point lies on the line
+	CounterClockwise Orientation = 1  // point lies to the left of the directed line
+)
+
+// Orient2D - returns a positive value if a, b and c occur in counterclockwise order, a negative value
+// if they occur in clockwise order and zero if they are collinear. The value approximates twice the
+// signed area of the triangle abc, and its sign is exact.
+func Orient2D(a, b, c point.Point) float64 {
+	ax, ay := float64(a.X), float64(a.Y)
+	bx, by := float64(b.X), float64(b.Y)
+	cx, cy := float64(c.X), float64(c.Y)
+
+	detLeft := (ax - cx) * (by - cy)
+	detRight := (ay - cy) * (bx - cx)
+	det := detLeft - detRight
+	detSum := math.Abs(detLeft) + math.Abs(detRight)
+	if math.Abs(det) >= orientErrorBound*detSum {
+		return det
+	}
+	return orient2DExact(ax, ay, bx, by, cx, cy)
+}
+
+// orient2DExact - evaluate orientation determinant with exact arithmetic
+func orient2DExact(ax, ay, bx, by, cx, cy float64) float64 {
+	acx, acy := exactDiff(ax, cx), exactDiff(ay, cy)
+	bcx, bcy := exactDiff(bx, cx), exactDiff(by, cy)
+	det := sum(product(acx, bcy), negate(product(acy, bcx)))
+	return signedEstimate(det)
+}
+
+// Orient - returns the orientation of c relative to the directed line from a to b
+func Orient(a, b, c point.Point) Orientation {
+	return orientationOf(Orient2D(a, b, c))
+}
+
+// InCircle - returns a positive value if d lies inside the circle through a, b and c, a negative value
+// if it lies outside and zero if the four points are cocircular. a, b and c must occur in counterclockwise
+// order, otherwise the sign of the result is reversed. The sign of the result is exact.
+func InCircle(a, b, c, d point.Point) float64 {
+	ax, ay := float64(a.X), float64(a.Y)
+	bx, by := float64(b.X), float64(b.Y)
+	cx, cy := float64(c.X), float64(c.Y)
+	dx, dy := float64(d.X), float64(d.Y)
+
+	adx, ady := ax-dx, ay-dy
+	bdx, bdy := bx-dx, by-dy
+	cdx, cdy := cx-dx, cy-dy
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	aLift := adx*adx + ady*ady
+
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	bLift := bdx*bdx + bdy*bdy
+
+	adxbdy, bdxady := adx*bdy, bdx*ady
+	cLift := cdx*cdx + cdy*cdy
+
+	det := aLift*(bdxcdy-cdxbdy) + bLift*(cdxady-adxcdy) + cLift*(adxbdy-bdxady)
+	permanent := (math.Abs(bdxcdy)+math.Abs(cdxbdy))*aLift +
+		(math.Abs(cdxady)+math.Abs(adxcdy))*bLift +
+		(math.Abs(adxbdy)+math.Abs(bdxady))*cLift
+	if math.Abs(det) > inCircleErrorBound*permanent {
+		return det
+	}
+	return inCircleExact(ax, ay, bx, by, cx, cy, dx, dy)
+}
+
+// inCircleExact - evaluate incircle determinant with exact arithmetic
+func inCircleExact(ax, ay, bx, by, cx, cy, dx, dy float64) float64 {
+	adx, ady := exactDiff(ax, dx), exactDiff(ay, dy)
+	bdx, bdy := exactDiff(bx, dx), exactDiff(by, dy)
+	cdx, cdy := exactDiff(cx, dx), exactDiff(cy, dy)
+
+	aLift := sum(product(adx, adx), product(ady, ady))
+	bLift := sum(product(bdx, bdx), product(bdy, bdy))
+	cLift := sum(product(cdx, cdx), product(cdy, cdy))
+
+	bc := sum(product(bdx, cdy), negate(product(cdx, bdy)))
+	ca := sum(product(cdx, ady), negate(product(adx, cdy)))
+	ab := sum(product(adx, bdy), negate(product(bdx, ady)))
+
+	det := sum(sum(product(aLift, bc), product(bLift, ca)), product(cLift, ab))
+	return signedEstimate(det)
+}
+
+// signedEstimate - approximate value of an expansion, guaranteed to carry the exact sign
+func signedEstimate(e expansion) float64 {
+	s := sign(e)
+	value := estimate(e)
+	if s == 0 {
+		return 0
+	}
+	if value == 0 || (value > 0) != (s > 0) {
+		return s * math.SmallestNonzeroFloat64
+	}
+	return value
+}
+
+// orientationOf - convert signed determinant into an Orientation
+func orientationOf(det float64) Orientation {
+	switch {
+	case det > 0:
+		return CounterClockwise
+	case det < 0:
+		return Clockwise
+	default:
+		return Collinear
+	}
+}
+
+// SegmentsIntersect - returns boolean indicating whether the closed segments a1a2 and b1b2 share at least
+// one point. The decision is made with exact orientation tests, so it is always topologically consistent.
+func SegmentsIntersect(a1, a2, b1, b2 point.Point) bool {
+	o1 := Orient(a1, a2, b1)
+	o2 := Orient(a1, a2, b2)
+	o3 := Orient(b1, b2, a1)
+	o4 := Orient(b1, b2, a2)
+
+	// proper crossing, each segment's ends lie strictly either side of the other segment
+	if o1*o2 < 0 && o3*o4 < 0 {
+		return true
+	}
+	// otherwise segments can only meet if an end of one lies on the other
+	switch {
+	case o1 == Collinear && OnSegment(a1, a2, b1):
+		return true
+	case o2 == Collinear && OnSegment(a1, a2, b2):
+		return true
+	case o3 == Collinear && OnSegment(b1, b2, a1):
+		return true
+	case o4 == Collinear && OnSegment(b1, b2, a2):
+		return true
+	}
+	return false
+}
+
+// OnSegment - for p known to be collinear with a and b, returns boolean indicating whether p lies
+// within the closed segment ab. Comparisons are exact.
+func OnSegment(a, b, p point.Point) bool {
+	return math.Min(float64(a.X), float64(b.X)) <= float64(p.X) && float64(p.X) <= math.Max(float64(a.X), float64(b.X)) &&
+		math.Min(float64(a.Y), float64(b.Y)) <= float64(p.Y) && float64(p.Y) <= math.Max(float64(a.Y), float64(b.Y))
+}
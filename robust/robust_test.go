@@ -0,0 +1,76 @@
+package robust
+
+import (
+	"collision/point"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ratOrient2D - reference orientation determinant computed with rational arithmetic
+func ratOrient2D(a, b, c point.Point) int {
+	r := func(f float32) *big.Rat { return new(big.Rat).SetFloat64(float64(f)) }
+	acx := new(big.Rat).Sub(r(a.X), r(c.X))
+	acy := new(big.Rat).Sub(r(a.Y), r(c.Y))
+	bcx := new(big.Rat).Sub(r(b.X), r(c.X))
+	bcy := new(big.Rat).Sub(r(b.Y), r(c.Y))
+	left := new(big.Rat).Mul(acx, bcy)
+	right := new(big.Rat).Mul(acy, bcx)
+	return left.Sub(left, right).Sign()
+}
+
+// TestOrient2D - test that Orient2D gives exact signs for collinear and near collinear input
+func TestOrient2D(t *testing.T) {
+	a := point.Point{X: 0, Y: 0}
+	b := point.Point{X: 1, Y: 1}
+
+	assert.Greater(t, Orient2D(a, b, point.Point{X: 0, Y: 1}), 0.0, "point should be counterclockwise")
+	assert.Less(t, Orient2D(a, b, point.Point{X: 1, Y: 0}), 0.0, "point should be clockwise")
+	assert.Equal(t, 0.0, Orient2D(a, b, point.Point{X: 24, Y: 24}), "points should be collinear")
+
+	// points on a line not representable exactly in float32
+	a = point.Point{X: 0.1, Y: 0.1}
+	b = point.Point{X: 0.3, Y: 0.3}
+	for i := 0; i < 64; i++ {
+		c := point.Point{X: math.Nextafter32(0.2, 1) + float32(i)*1e-9, Y: 0.2}
+		assert.Equal(t, ratOrient2D(a, b, c), int(Orient(a, b, c)), "orientation should match exact reference")
+	}
+
+	// random near degenerate input compared against exact reference
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a := point.Point{X: rng.Float32() * 1000, Y: rng.Float32() * 1000}
+		b := point.Point{X: rng.Float32() * 1000, Y: rng.Float32() * 1000}
+		s := rng.Float32()
+		c := point.Point{X: a.X + s*(b.X-a.X), Y: a.Y + s*(b.Y-a.Y)}
+		assert.Equal(t, ratOrient2D(a, b, c), int(Orient(a, b, c)), "orientation should match exact reference")
+	}
+}
+
+// TestInCircle - test that InCircle behaves as expected
+func TestInCircle(t *testing.T) {
+	a := point.Point{X: 1, Y: 0}
+	b := point.Point{X: 0, Y: 1}
+	c := point.Point{X: -1, Y: 0}
+
+	assert.Greater(t, InCircle(a, b, c, point.Point{X: 0, Y: 0}), 0.0, "centre should be inside circle")
+	assert.Less(t, InCircle(a, b, c, point.Point{X: 2, Y: 2}), 0.0, "point should be outside circle")
+	assert.Equal(t, 0.0, InCircle(a, b, c, point.Point{X: 0, Y: -1}), "points should be cocircular")
+	assert.Less(t, InCircle(a, b, c, point.Point{X: 0, Y: math.Nextafter32(-1, -2)}), 0.0, "point should be just outside circle")
+	assert.Greater(t, InCircle(a, b, c, point.Point{X: 0, Y: math.Nextafter32(-1, 0)}), 0.0, "point should be just inside circle")
+}
+
+// TestSegmentsIntersect - test that SegmentsIntersect behaves as expected
+func TestSegmentsIntersect(t *testing.T) {
+	a1, a2 := point.Point{X: 0, Y: 0}, point.Point{X: 10, Y: 10}
+
+	assert.True(t, SegmentsIntersect(a1, a2, point.Point{X: 0, Y: 10}, point.Point{X: 10, Y: 0}), "segments should cross")
+	assert.True(t, SegmentsIntersect(a1, a2, point.Point{X: 5, Y: 5}, point.Point{X: 10, Y: 0}), "segments should touch")
+	assert.True(t, SegmentsIntersect(a1, a2, point.Point{X: 5, Y: 5}, point.Point{X: 20, Y: 20}), "collinear segments should overlap")
+	assert.False(t, SegmentsIntersect(a1, a2, point.Point{X: 11, Y: 11}, point.Point{X: 20, Y: 20}), "collinear segments should not overlap")
+	assert.False(t, SegmentsIntersect(a1, a2, point.Point{X: 0, Y: 1}, point.Point{X: 10, Y: 11}), "parallel segments should not meet")
+	assert.False(t, SegmentsIntersect(a1, a2, point.Point{X: 5, Y: math.Nextafter32(5, 6)}, point.Point{X: 0, Y: 10}), "segments should just miss")
+}